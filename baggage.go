@@ -0,0 +1,113 @@
+package telemetry
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Baggage represents the configuration of the processors copying W3C baggage members, propagated via [propagation.Baggage],
+// onto every started span and emitted log record as attributes.
+type Baggage struct {
+	// Keys is the allowlist of baggage member keys copied as attributes; glob patterns (see [SeverityFilter] for the syntax)
+	// are supported, e.g. "tenant" or "request.*". Members not matching any key are ignored.
+	Keys []string
+
+	// Prefix is prepended to each member's key to form the attribute key, e.g. "baggage.". Defaults to no prefix.
+	Prefix string
+
+	// Limit is the maximum length, in bytes, of copied values; longer values are truncated at a character boundary.
+	// Defaults to 0, i.e. unlimited.
+	Limit int
+}
+
+// allowed reports whether the baggage member key is allowlisted.
+func (b *Baggage) allowed(key string) bool {
+	for _, pattern := range b.Keys {
+		if glob(pattern, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// truncate shortens value to [Baggage.Limit] bytes without splitting a multibyte character.
+func (b *Baggage) truncate(value string) string {
+	if b.Limit <= 0 || len(value) <= b.Limit {
+		return value
+	}
+
+	n := b.Limit
+	for n > 0 && !(utf8.RuneStart(value[n])) {
+		n--
+	}
+
+	return value[:n]
+}
+
+// members calls fn with the attribute key and value of each allowlisted member of the context's baggage.
+func (b *Baggage) members(ctx context.Context, fn func(key, value string)) {
+	for _, member := range baggage.FromContext(ctx).Members() {
+		if b.allowed(member.Key()) {
+			fn(b.Prefix+member.Key(), b.truncate(member.Value()))
+		}
+	}
+}
+
+// baggaged is a [trace.SpanProcessor] stamping allowlisted baggage members onto started spans.
+type baggaged struct {
+	settings *Baggage
+}
+
+func (p *baggaged) OnStart(ctx context.Context, s trace.ReadWriteSpan) {
+	p.settings.members(ctx, func(key, value string) {
+		s.SetAttributes(attribute.String(key, value))
+	})
+}
+
+func (p *baggaged) OnEnd(trace.ReadOnlySpan) {}
+
+func (p *baggaged) Shutdown(context.Context) error {
+	return nil
+}
+
+func (p *baggaged) ForceFlush(context.Context) error {
+	return nil
+}
+
+// baggagedRecords is a [log.Processor] stamping allowlisted baggage members onto records before forwarding them to the
+// next processor.
+type baggagedRecords struct {
+	settings *Baggage
+	next     log.Processor
+}
+
+func (p *baggagedRecords) OnEmit(ctx context.Context, record *log.Record) error {
+	p.settings.members(ctx, func(key, value string) {
+		record.AddAttributes(otellog.String(key, value))
+	})
+
+	return p.next.OnEmit(ctx, record)
+}
+
+func (p *baggagedRecords) Enabled(ctx context.Context, parameters log.EnabledParameters) bool {
+	if next, ok := p.next.(log.FilterProcessor); ok {
+		return next.Enabled(ctx, parameters)
+	}
+
+	return true
+}
+
+func (p *baggagedRecords) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+func (p *baggagedRecords) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
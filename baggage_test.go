@@ -0,0 +1,63 @@
+package telemetry_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/contrib/bridges/otelslog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/baggage"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+func TestBaggage(t *testing.T) {
+	t.Run("Telemetry-Baggage-Attributes", func(t *testing.T) {
+		ctx := context.Background()
+
+		var traces, logs bytes.Buffer
+
+		shutdown := local(t, &traces, &logs, func(options *telemetry.Settings) {
+			options.Baggage = &telemetry.Baggage{
+				Keys:   []string{"tenant", "request.*"},
+				Prefix: "baggage.",
+				Limit:  8,
+			}
+		})
+
+		b, e := baggage.Parse("tenant=acme,request.tier=premium-unlimited,session=secret-session")
+		if e != nil {
+			t.Fatalf("Unexpected Error While Parsing Baggage: %v", e)
+		}
+
+		ctx = baggage.ContextWithBaggage(ctx, b)
+
+		ctx, span := otel.Tracer("github.com/application").Start(ctx, "Baggage Span")
+		otelslog.NewLogger("github.com/application").InfoContext(ctx, "Baggage Message")
+		span.End()
+
+		if e := shutdown(context.Background()); e != nil {
+			t.Fatalf("Unexpected Error During Shutdown: %v", e)
+		}
+
+		for signal, buffer := range map[string]*bytes.Buffer{"Traces": &traces, "Logs": &logs} {
+			t.Run(signal, func(t *testing.T) {
+				output := buffer.String()
+
+				for _, expected := range []string{"baggage.tenant", "acme", "baggage.request.tier", `"premium-"`} {
+					if !(strings.Contains(output, expected)) {
+						t.Errorf("Exported Telemetry Missing %s:\n%s", expected, output)
+					}
+				}
+
+				for _, unexpected := range []string{"unlimited", "session"} {
+					if strings.Contains(output, unexpected) {
+						t.Errorf("Exported Telemetry Unexpectedly Contains %s:\n%s", unexpected, output)
+					}
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,129 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"log"
+	"log/slog"
+	"os"
+
+	"go.opentelemetry.io/contrib/bridges/otelslog"
+	otellog "go.opentelemetry.io/otel/log"
+)
+
+// Bridge represents the configuration of the [slog.Default] handler installed by [Setup]. Records are fanned out to both
+// the OpenTelemetry logger provider and a local console handler, each with its own minimum level.
+type Bridge struct {
+	// Name is the instrumentation scope name of the bridged logger. Defaults to "github.com/poly-gun/go-telemetry".
+	Name string
+
+	// Level is the minimum level of records forwarded to the OpenTelemetry logger provider. Defaults to [slog.LevelInfo].
+	Level slog.Leveler
+
+	// Console is the local handler additionally receiving records, e.g. a [slog.JSONHandler]. Defaults to a [slog.TextHandler]
+	// writing to [os.Stderr].
+	Console slog.Handler
+
+	// ConsoleLevel is the minimum level of records forwarded to [Bridge.Console], applied in addition to the console handler's
+	// own level. Defaults to [slog.LevelInfo].
+	ConsoleLevel slog.Leveler
+
+	// Source includes the source code position of the log call in records forwarded to the logger provider. Default is false.
+	Source bool
+}
+
+// destination is a [slog.Handler] paired with the minimum level of records it receives.
+type destination struct {
+	handler slog.Handler
+	level   slog.Leveler
+}
+
+// fanout is a [slog.Handler] dispatching each record to every destination whose level it satisfies.
+type fanout []destination
+
+func (f fanout) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, d := range f {
+		if level >= d.level.Level() && d.handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f fanout) Handle(ctx context.Context, record slog.Record) error {
+	var e error
+	for _, d := range f {
+		if record.Level >= d.level.Level() && d.handler.Enabled(ctx, record.Level) {
+			e = errors.Join(e, d.handler.Handle(ctx, record.Clone()))
+		}
+	}
+
+	return e
+}
+
+func (f fanout) WithAttrs(attributes []slog.Attr) slog.Handler {
+	handlers := make(fanout, 0, len(f))
+	for _, d := range f {
+		handlers = append(handlers, destination{handler: d.handler.WithAttrs(attributes), level: d.level})
+	}
+
+	return handlers
+}
+
+func (f fanout) WithGroup(name string) slog.Handler {
+	handlers := make(fanout, 0, len(f))
+	for _, d := range f {
+		handlers = append(handlers, destination{handler: d.handler.WithGroup(name), level: d.level})
+	}
+
+	return handlers
+}
+
+// bridge installs the [Logs.Bridge] handler as the [slog.Default] logger. The returned function restores the original
+// default logger, including the output of the standard library's [log] package which [slog.SetDefault] redirects.
+func bridge(settings *Settings, provider otellog.LoggerProvider) (restore func(context.Context) error) {
+	restore = func(context.Context) error { return nil }
+
+	if settings.Logs.Bridge == nil {
+		return
+	}
+
+	b := settings.Logs.Bridge
+
+	name := b.Name
+	if name == "" {
+		name = "github.com/poly-gun/go-telemetry"
+	}
+
+	var level slog.Leveler = slog.LevelInfo
+	if b.Level != nil {
+		level = b.Level
+	}
+
+	console := b.Console
+	if console == nil {
+		console = slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})
+	}
+
+	var consoleLevel slog.Leveler = slog.LevelInfo
+	if b.ConsoleLevel != nil {
+		consoleLevel = b.ConsoleLevel
+	}
+
+	original, writer, flags := slog.Default(), log.Writer(), log.Flags()
+
+	slog.SetDefault(slog.New(fanout{
+		{handler: otelslog.NewHandler(name, otelslog.WithLoggerProvider(provider), otelslog.WithSource(b.Source)), level: level},
+		{handler: console, level: consoleLevel},
+	}))
+
+	return func(context.Context) error {
+		slog.SetDefault(original)
+
+		log.SetOutput(writer)
+		log.SetFlags(flags)
+
+		return nil
+	}
+}
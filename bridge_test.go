@@ -0,0 +1,63 @@
+package telemetry_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+func TestBridge(t *testing.T) {
+	t.Run("Telemetry-Default-Slog-Bridge", func(t *testing.T) {
+		ctx := context.Background()
+
+		original := slog.Default()
+
+		var logs, console bytes.Buffer
+
+		shutdown := local(t, io.Discard, &logs, func(options *telemetry.Settings) {
+			options.Logs.Bridge = &telemetry.Bridge{
+				Level:        slog.LevelWarn,
+				Console:      slog.NewJSONHandler(&console, &slog.HandlerOptions{Level: slog.LevelDebug}),
+				ConsoleLevel: slog.LevelDebug,
+			}
+		})
+
+		if slog.Default() == original {
+			t.Fatal("Default Slog Handler Not Installed")
+		}
+
+		slog.DebugContext(ctx, "Bridge Debug Message")
+		slog.WarnContext(ctx, "Bridge Warning Message")
+
+		if e := shutdown(ctx); e != nil {
+			t.Fatalf("Unexpected Error During Shutdown: %v", e)
+		}
+
+		if slog.Default() != original {
+			t.Error("Original Default Slog Handler Not Restored")
+		}
+
+		t.Run("Console", func(t *testing.T) {
+			for _, message := range []string{"Bridge Debug Message", "Bridge Warning Message"} {
+				if !(strings.Contains(console.String(), message)) {
+					t.Errorf("Console Missing Message %q:\n%s", message, console.String())
+				}
+			}
+		})
+
+		t.Run("Provider", func(t *testing.T) {
+			if strings.Contains(logs.String(), "Bridge Debug Message") {
+				t.Errorf("Provider Received Message Below Its Minimum Level:\n%s", logs.String())
+			}
+
+			if !(strings.Contains(logs.String(), "Bridge Warning Message")) {
+				t.Errorf("Provider Missing Warning Message:\n%s", logs.String())
+			}
+		})
+	})
+}
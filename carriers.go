@@ -0,0 +1,210 @@
+package telemetry
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+)
+
+// Table is a [propagation.TextMapCarrier] adapting header tables whose values are untyped, e.g. AMQP message headers
+// (amqp091.Table). Values are written as strings; string and []byte values are read.
+type Table map[string]any
+
+// Get returns the value associated with the passed key.
+func (t Table) Get(key string) string {
+	switch value := t[key].(type) {
+	case string:
+		return value
+	case []byte:
+		return string(value)
+	}
+
+	return ""
+}
+
+// Set stores the key-value pair.
+func (t Table) Set(key, value string) {
+	t[key] = value
+}
+
+// Keys lists the keys stored in this carrier.
+func (t Table) Keys() []string {
+	keys := make([]string, 0, len(t))
+	for key := range t {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// Multimap is a [propagation.TextMapCarrier] adapting case-sensitive, multi-valued header maps, e.g. NATS headers or gRPC
+// metadata. Unlike [propagation.HeaderCarrier], keys are used as-is rather than canonicalized.
+type Multimap map[string][]string
+
+// Get returns the first value associated with the passed key.
+func (m Multimap) Get(key string) string {
+	if values := m[key]; len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// Set stores the key-value pair, replacing any existing values.
+func (m Multimap) Set(key, value string) {
+	m[key] = []string{value}
+}
+
+// Keys lists the keys stored in this carrier.
+func (m Multimap) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// List is a [propagation.TextMapCarrier] adapting ordered lists of headers, e.g. Kafka record headers, whose element type T is
+// defined by the messaging client. See [HeaderList].
+type List[T any] struct {
+	headers *[]T
+	key     func(T) string
+	value   func(T) []byte
+	header  func(key string, value []byte) T
+}
+
+// HeaderList creates a [List] carrier over headers, using key and value to read a header's fields, and header to construct
+// a new one. For example, using the github.com/segmentio/kafka-go client:
+//
+//	carrier := telemetry.HeaderList(&message.Headers,
+//		func(h kafka.Header) string { return h.Key },
+//		func(h kafka.Header) []byte { return h.Value },
+//		func(key string, value []byte) kafka.Header { return kafka.Header{Key: key, Value: value} },
+//	)
+//
+//	otel.GetTextMapPropagator().Inject(ctx, carrier)
+func HeaderList[T any](headers *[]T, key func(T) string, value func(T) []byte, header func(key string, value []byte) T) *List[T] {
+	return &List[T]{headers: headers, key: key, value: value, header: header}
+}
+
+// Get returns the value of the last header with the passed key.
+func (l *List[T]) Get(key string) string {
+	for i := len(*l.headers) - 1; i >= 0; i-- {
+		if h := (*l.headers)[i]; l.key(h) == key {
+			return string(l.value(h))
+		}
+	}
+
+	return ""
+}
+
+// Set stores the key-value pair, removing any existing headers with the same key.
+func (l *List[T]) Set(key, value string) {
+	*l.headers = slices.DeleteFunc(*l.headers, func(h T) bool {
+		return l.key(h) == key
+	})
+
+	*l.headers = append(*l.headers, l.header(key, []byte(value)))
+}
+
+// Keys lists the keys stored in this carrier.
+func (l *List[T]) Keys() []string {
+	keys := make([]string, 0, len(*l.headers))
+	for _, h := range *l.headers {
+		keys = append(keys, l.key(h))
+	}
+
+	return keys
+}
+
+// Environment is a [propagation.TextMapCarrier] adapting "KEY=value" environment entries, e.g. [os.Environ] or [exec.Cmd.Env].
+// Propagation keys map to upper-case variable names, with "-" and "." replaced by "_", e.g. "traceparent" to "TRACEPARENT".
+type Environment struct {
+	Entries *[]string
+}
+
+// variable returns the environment variable name of a propagation key.
+func variable(key string) string {
+	return strings.NewReplacer("-", "_", ".", "_").Replace(strings.ToUpper(key))
+}
+
+// Get returns the value of the variable associated with the passed key.
+func (e Environment) Get(key string) string {
+	prefix := variable(key) + "="
+
+	for i := len(*e.Entries) - 1; i >= 0; i-- {
+		if value, found := strings.CutPrefix((*e.Entries)[i], prefix); found {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// Set stores the key-value pair, replacing any existing variable of the same name.
+func (e Environment) Set(key, value string) {
+	name := variable(key)
+
+	*e.Entries = slices.DeleteFunc(*e.Entries, func(entry string) bool {
+		return strings.HasPrefix(entry, name+"=")
+	})
+
+	*e.Entries = append(*e.Entries, name+"="+value)
+}
+
+// Keys lists the lower-case names of the variables stored in this carrier.
+func (e Environment) Keys() []string {
+	keys := make([]string, 0, len(*e.Entries))
+	for _, entry := range *e.Entries {
+		if name, _, found := strings.Cut(entry, "="); found {
+			keys = append(keys, strings.ToLower(name))
+		}
+	}
+
+	return keys
+}
+
+// Command injects the context's trace context and baggage into the child process's environment using the global propagator,
+// i.e. the TRACEPARENT, TRACESTATE and BAGGAGE variables by default. If the command's environment is nil, it's initialized
+// from [os.Environ], preserving the child's default of inheriting the parent's environment.
+func Command(ctx context.Context, cmd *exec.Cmd) *exec.Cmd {
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+
+	otel.GetTextMapPropagator().Inject(ctx, Environment{Entries: &cmd.Env})
+
+	return cmd
+}
+
+// inherited is the environment captured by [Setup] when [Settings.Inherit] is enabled.
+var inherited atomic.Pointer[[]string]
+
+// Inherited returns a copy of ctx carrying the trace context and baggage the parent process passed via [Command], allowing
+// the process's spans to continue the parent's trace. Requires [Settings.Inherit]; otherwise ctx is returned unchanged.
+func Inherited(ctx context.Context) context.Context {
+	entries := inherited.Load()
+	if entries == nil {
+		return ctx
+	}
+
+	return otel.GetTextMapPropagator().Extract(ctx, Environment{Entries: entries})
+}
+
+// inherit captures the process's environment for [Inherited], if configured.
+func inherit(settings *Settings) {
+	if !(settings.Inherit) {
+		inherited.Store(nil)
+		return
+	}
+
+	environment := os.Environ()
+
+	inherited.Store(&environment)
+}
@@ -0,0 +1,101 @@
+package telemetry_test
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+// header mirrors the record header shape of common Kafka clients.
+type header struct {
+	Key   string
+	Value []byte
+}
+
+func TestCarriers(t *testing.T) {
+	ctx := context.Background()
+
+	shutdown := local(t, io.Discard, io.Discard)
+
+	defer shutdown(ctx)
+
+	member, _ := baggage.NewMember("tenant", "acme")
+	b, _ := baggage.New(member)
+
+	ctx, span := otel.Tracer("github.com/application").Start(baggage.ContextWithBaggage(ctx, b), "Parent Span")
+	defer span.End()
+
+	// verify asserts the extracted context continues the span and carries its baggage.
+	verify := func(t *testing.T, extracted context.Context) {
+		t.Helper()
+
+		if sc := trace.SpanContextFromContext(extracted); sc.TraceID() != span.SpanContext().TraceID() || sc.SpanID() != span.SpanContext().SpanID() {
+			t.Errorf("Extracted Span Context Mismatch - Expected: %s, Received: %s", span.SpanContext().TraceID(), sc.TraceID())
+		}
+
+		if tenant := baggage.FromContext(extracted).Member("tenant").Value(); tenant != "acme" {
+			t.Errorf("Extracted Baggage Mismatch - Expected: acme, Received: %q", tenant)
+		}
+	}
+
+	carriers := map[string]propagation.TextMapCarrier{
+		"Table":    telemetry.Table{},
+		"Multimap": telemetry.Multimap{},
+		"List": telemetry.HeaderList(&[]header{{Key: "traceparent", Value: []byte("stale")}},
+			func(h header) string { return h.Key },
+			func(h header) []byte { return h.Value },
+			func(key string, value []byte) header { return header{Key: key, Value: value} },
+		),
+	}
+
+	for name, carrier := range carriers {
+		t.Run("Telemetry-"+name+"-Carrier", func(t *testing.T) {
+			otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+			verify(t, otel.GetTextMapPropagator().Extract(context.Background(), carrier))
+		})
+	}
+
+	t.Run("Telemetry-Child-Process-Environment", func(t *testing.T) {
+		cmd := telemetry.Command(ctx, exec.Command("env"))
+
+		var variables int
+		for _, entry := range cmd.Env {
+			if strings.HasPrefix(entry, "TRACEPARENT=") || strings.HasPrefix(entry, "BAGGAGE=") {
+				variables++
+			}
+		}
+
+		if variables != 2 {
+			t.Fatalf("Expected TRACEPARENT and BAGGAGE Variables: %v", cmd.Env)
+		}
+
+		// Simulate the child process's startup.
+		for _, entry := range cmd.Env {
+			if name, value, _ := strings.Cut(entry, "="); name == "TRACEPARENT" || name == "BAGGAGE" {
+				t.Setenv(name, value)
+			}
+		}
+
+		if sc := trace.SpanContextFromContext(telemetry.Inherited(context.Background())); sc.IsValid() {
+			t.Error("Unexpected Inherited Span Context Without Settings.Inherit")
+		}
+
+		child := local(t, io.Discard, io.Discard, func(options *telemetry.Settings) {
+			options.Inherit = true
+		})
+
+		defer child(context.Background())
+
+		verify(t, telemetry.Inherited(context.Background()))
+	})
+}
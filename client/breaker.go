@@ -0,0 +1,255 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// State is the state of a host's circuit breaker.
+type State int
+
+const (
+	// Closed circuits send every request.
+	Closed State = iota
+
+	// Open circuits reject every request, failing fast, until their cooldown elapses.
+	Open
+
+	// HalfOpen circuits send a single trial request at a time, rejecting the others; the trials' outcomes close or reopen
+	// the circuit.
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	}
+
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// ErrCircuitOpen is matched, via [errors.Is], by the errors of requests rejected by a host's circuit breaker.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// CircuitError is returned by [Client.RoundTrip], and wrapped in a [url.Error] by [Client.Do], for requests rejected by
+// their host's circuit breaker.
+type CircuitError struct {
+	// Host is the host and port of the rejected request's URL.
+	Host string
+
+	// State is the state of the host's circuit.
+	State State
+}
+
+func (e *CircuitError) Error() string {
+	return fmt.Sprintf("circuit breaker %s for host %s", e.State, e.Host)
+}
+
+func (e *CircuitError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// Breaker represents the configuration of a [Client]'s per-host circuit breaker; see [Options.Breaker].
+type Breaker struct {
+	// Threshold is the number of consecutive failed requests to a host opening its circuit. Defaults to 5.
+	Threshold int
+
+	// Cooldown is the period an open circuit rejects requests before allowing a trial request. Defaults to 30 seconds.
+	Cooldown time.Duration
+
+	// Trials is the number of consecutive successful trial requests closing a half-open circuit. Defaults to 1.
+	Trials int
+
+	// Failure reports whether the outcome of a request counts as a failure. Defaults to [Failure].
+	Failure func(response *http.Response, e error) bool
+}
+
+func (o *Breaker) defaults() {
+	if o.Threshold <= 0 {
+		o.Threshold = 5
+	}
+
+	if o.Cooldown <= 0 {
+		o.Cooldown = 30 * time.Second
+	}
+
+	if o.Trials <= 0 {
+		o.Trials = 1
+	}
+
+	if o.Failure == nil {
+		o.Failure = Failure
+	}
+}
+
+// Failure is the default [Breaker.Failure]: transport errors, other than canceled requests, and 429 and 5xx responses
+// count as failures.
+func Failure(response *http.Response, e error) bool {
+	if e != nil {
+		return !(errors.Is(e, context.Canceled))
+	}
+
+	return response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= 500
+}
+
+// circuit is the circuit breaker of a single host.
+type circuit struct {
+	client *Client
+	host   string
+
+	mutex     sync.Mutex
+	state     State
+	failures  int
+	successes int
+	opened    time.Time
+	probing   bool
+}
+
+// circuit returns the circuit of the request's host, or nil if the client has no circuit breaker.
+func (c *Client) circuit(ctx context.Context, r *http.Request) *circuit {
+	if c.options.Breaker == nil {
+		return nil
+	}
+
+	if existing, ok := c.circuits.Load(r.URL.Host); ok {
+		return existing.(*circuit)
+	}
+
+	existing, loaded := c.circuits.LoadOrStore(r.URL.Host, &circuit{client: c, host: r.URL.Host})
+	if !(loaded) {
+		c.instruments.state.Record(ctx, int64(Closed), metric.WithAttributeSet(attribute.NewSet(address(r)...)))
+	}
+
+	return existing.(*circuit)
+}
+
+// admit reports whether the request may be sent, and whether it's a trial of a half-open circuit. Rejected requests
+// return a [*CircuitError].
+func (c *circuit) admit(ctx context.Context, r *http.Request) (bool, error) {
+	if c == nil {
+		return false, nil
+	}
+
+	c.mutex.Lock()
+
+	from := c.state
+	if c.state == Open && time.Since(c.opened) >= c.client.options.Breaker.Cooldown {
+		c.state = HalfOpen
+		c.successes = 0
+	}
+
+	to := c.state
+
+	admitted, trial := true, false
+	switch c.state {
+	case Open:
+		admitted = false
+	case HalfOpen:
+		admitted, trial = !(c.probing), !(c.probing)
+		c.probing = true
+	}
+
+	c.mutex.Unlock()
+
+	c.transition(ctx, r, from, to)
+
+	if !(admitted) {
+		return false, &CircuitError{Host: c.host, State: to}
+	}
+
+	return trial, nil
+}
+
+// settle records the outcome of an admitted request. Requests canceled by their caller don't count toward the circuit's
+// state; those exceeding their deadline count as failures.
+func (c *circuit) settle(ctx context.Context, r *http.Request, trial bool, response *http.Response, e error) {
+	if c == nil {
+		return
+	}
+
+	abandoned := errors.Is(ctx.Err(), context.Canceled)
+	failed := !(abandoned) && c.client.options.Breaker.Failure(response, e)
+
+	c.mutex.Lock()
+
+	if trial {
+		c.probing = false
+	}
+
+	from := c.state
+
+	switch {
+	case abandoned:
+	case c.state == Closed && failed:
+		c.failures++
+		if c.failures >= c.client.options.Breaker.Threshold {
+			c.state = Open
+			c.opened = time.Now()
+		}
+	case c.state == Closed:
+		c.failures = 0
+	case c.state == HalfOpen && trial && failed:
+		c.state = Open
+		c.opened = time.Now()
+	case c.state == HalfOpen && trial:
+		c.successes++
+		if c.successes >= c.client.options.Breaker.Trials {
+			c.state = Closed
+			c.failures = 0
+		}
+	}
+
+	to := c.state
+
+	c.mutex.Unlock()
+
+	c.transition(ctx, r, from, to)
+}
+
+// transition emits the circuit's state change, if any, as an event of the context's span, a log and the state gauge.
+func (c *circuit) transition(ctx context.Context, r *http.Request, from, to State) {
+	if from == to {
+		return
+	}
+
+	attributes := address(r)
+
+	trace.SpanFromContext(ctx).AddEvent("circuit_breaker.transition", trace.WithAttributes(append(attributes,
+		attribute.String("circuit_breaker.previous_state", from.String()),
+		attribute.String("circuit_breaker.state", to.String()),
+	)...))
+
+	level := slog.LevelInfo
+	if to == Open {
+		level = slog.LevelWarn
+	}
+
+	slog.Log(ctx, level, "HTTP Client Circuit Breaker Transition", slog.String("name", c.client.options.Name), slog.String("host", c.host), slog.String("from", from.String()), slog.String("to", to.String()))
+
+	c.client.instruments.state.Record(ctx, int64(to), metric.WithAttributeSet(attribute.NewSet(attributes...)))
+}
+
+// address returns the server address and port attributes of the request.
+func address(r *http.Request) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{semconv.ServerAddress(r.URL.Hostname())}
+	if value, ok := port(r.URL); ok {
+		attributes = append(attributes, semconv.ServerPort(value))
+	}
+
+	return attributes
+}
@@ -0,0 +1,203 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/poly-gun/go-telemetry/client"
+)
+
+// body records whether it was closed.
+type body struct {
+	io.Reader
+	closed atomic.Bool
+}
+
+func (b *body) Close() error {
+	b.closed.Store(true)
+
+	return nil
+}
+
+func TestBreaker(t *testing.T) {
+	// status is the response status of the server; release, if not nil, is awaited before responding.
+	var status atomic.Int64
+	var release atomic.Pointer[chan struct{}]
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ch := release.Load(); ch != nil {
+			select {
+			case <-*ch:
+			case <-r.Context().Done():
+			}
+		}
+
+		w.WriteHeader(int(status.Load()))
+	}))
+
+	defer server.Close()
+
+	send := func(c *client.Client, ctx context.Context) (int, error) {
+		request, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+		response, e := c.Do(request)
+		if e != nil {
+			return 0, e
+		}
+
+		response.Body.Close()
+
+		return response.StatusCode, nil
+	}
+
+	breaker := func(o *client.Options) {
+		o.Breaker = &client.Breaker{Threshold: 2, Cooldown: 50 * time.Millisecond, Trials: 2}
+	}
+
+	t.Run("Telemetry-Client-Breaker-Lifecycle", func(t *testing.T) {
+		recorder, reader := instrumented(t)
+
+		c := client.New(breaker)
+
+		// Closed to open after the threshold of consecutive failures.
+		status.Store(http.StatusInternalServerError)
+		for range 2 {
+			if _, e := send(c, context.Background()); e != nil {
+				t.Fatalf("Unexpected Error While Circuit Closed: %v", e)
+			}
+		}
+
+		// Fast-fail, closing the rejected request's body.
+		rejected := &body{Reader: strings.NewReader("payload")}
+		request, _ := http.NewRequest(http.MethodPost, server.URL, rejected)
+		if _, e := c.Do(request); !(errors.Is(e, client.ErrCircuitOpen)) {
+			t.Fatalf("Expected Circuit Open Error, Received: %v", e)
+		}
+
+		var circuit *client.CircuitError
+		if _, e := send(c, context.Background()); !(errors.As(e, &circuit)) || circuit.State != client.Open {
+			t.Fatalf("Unexpected Circuit Error: %v", e)
+		}
+
+		if !(rejected.closed.Load()) {
+			t.Error("Rejected Request's Body Not Closed")
+		}
+
+		// A failed trial, after the cooldown, reopens the circuit.
+		time.Sleep(60 * time.Millisecond)
+
+		if code, e := send(c, context.Background()); e != nil || code != http.StatusInternalServerError {
+			t.Fatalf("Expected Trial Request - Status: %d, Error: %v", code, e)
+		}
+
+		if _, e := send(c, context.Background()); !(errors.Is(e, client.ErrCircuitOpen)) {
+			t.Fatalf("Expected Reopened Circuit, Received: %v", e)
+		}
+
+		// Successful trials, after the cooldown, close the circuit.
+		time.Sleep(60 * time.Millisecond)
+
+		status.Store(http.StatusOK)
+		for i := range 3 {
+			if code, e := send(c, context.Background()); e != nil || code != http.StatusOK {
+				t.Fatalf("Unexpected Outcome of Request %d - Status: %d, Error: %v", i, code, e)
+			}
+		}
+
+		var transitions []string
+		for _, span := range recorder.Ended() {
+			for _, event := range span.Events() {
+				if event.Name == "circuit_breaker.transition" {
+					from, _ := value(event.Attributes, "circuit_breaker.previous_state")
+					to, _ := value(event.Attributes, "circuit_breaker.state")
+
+					transitions = append(transitions, from.AsString()+">"+to.AsString())
+				}
+			}
+		}
+
+		expected := "closed>open open>half-open half-open>open open>half-open half-open>closed"
+		if strings.Join(transitions, " ") != expected {
+			t.Errorf("Unexpected Transitions - Expected: %s, Received: %s", expected, strings.Join(transitions, " "))
+		}
+
+		gauge, ok := find(t, reader, "http.client.circuit_breaker.state").(metricdata.Gauge[int64])
+		if !(ok) || len(gauge.DataPoints) != 1 || gauge.DataPoints[0].Value != int64(client.Closed) {
+			t.Errorf("Unexpected State Gauge: %+v", gauge)
+		}
+	})
+
+	t.Run("Telemetry-Client-Breaker-Single-Trial", func(t *testing.T) {
+		instrumented(t)
+
+		c := client.New(breaker)
+
+		status.Store(http.StatusInternalServerError)
+		for range 2 {
+			_, _ = send(c, context.Background())
+		}
+
+		time.Sleep(60 * time.Millisecond)
+
+		// Hold the trial request in flight; concurrent requests are rejected.
+		ch := make(chan struct{})
+		release.Store(&ch)
+		defer release.Store(nil)
+
+		status.Store(http.StatusOK)
+
+		trial := make(chan error, 1)
+		go func() {
+			_, e := send(c, context.Background())
+			trial <- e
+		}()
+
+		time.Sleep(20 * time.Millisecond)
+
+		if _, e := send(c, context.Background()); !(errors.Is(e, client.ErrCircuitOpen)) {
+			t.Errorf("Expected Concurrent Request Rejected, Received: %v", e)
+		}
+
+		close(ch)
+
+		if e := <-trial; e != nil {
+			t.Errorf("Unexpected Error of Trial Request: %v", e)
+		}
+	})
+
+	t.Run("Telemetry-Client-Breaker-Canceled-Requests", func(t *testing.T) {
+		instrumented(t)
+
+		c := client.New(breaker)
+
+		ch := make(chan struct{})
+		release.Store(&ch)
+
+		status.Store(http.StatusInternalServerError)
+
+		for range 3 {
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(10*time.Millisecond, cancel)
+
+			if _, e := send(c, ctx); !(errors.Is(e, context.Canceled)) {
+				t.Fatalf("Expected Canceled Request, Received: %v", e)
+			}
+		}
+
+		release.Store(nil)
+		close(ch)
+
+		if code, e := send(c, context.Background()); e != nil || code != http.StatusInternalServerError {
+			t.Errorf("Canceled Requests Counted as Failures - Status: %d, Error: %v", code, e)
+		}
+	})
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Capture represents the configuration of request and response body capture; see [Options.Capture]. Bodies are
+// captured as they're read by the transport and the caller, respectively, so neither is consumed nor buffered beyond
+// [Capture.Limit].
+type Capture struct {
+	// Limit is the maximum number of bytes captured of each body. Defaults to 4 KiB.
+	Limit int
+
+	// Types are the media types of the captured bodies, matched against the Content-Type header as [path.Match] patterns,
+	// e.g. "application/*+json". Defaults to "application/json", "application/*+json", "application/xml",
+	// "application/*+xml", "application/x-www-form-urlencoded" and "text/plain".
+	Types []string
+
+	// Redactors are applied, in order, to the content of each captured body, given its media type, before it's emitted.
+	Redactors []func(media, content string) string
+
+	// Logs emits the captured bodies as log records, rather than as events of the request's span. Default is false.
+	Logs bool
+}
+
+func (o *Capture) defaults() {
+	if o.Limit <= 0 {
+		o.Limit = 4 << 10
+	}
+
+	if o.Types == nil {
+		o.Types = []string{"application/json", "application/*+json", "application/xml", "application/*+xml", "application/x-www-form-urlencoded", "text/plain"}
+	}
+}
+
+// allowed returns the media type of the Content-Type header, and whether bodies of the type are captured.
+func (o *Capture) allowed(header string) (string, bool) {
+	media, _, e := mime.ParseMediaType(header)
+	if e != nil {
+		return "", false
+	}
+
+	for _, pattern := range o.Types {
+		if matched, _ := path.Match(pattern, media); matched {
+			return media, true
+		}
+	}
+
+	return media, false
+}
+
+// recording is a body capturing up to limit bytes of its content as it's read. Once the body is exhausted or closed, or
+// finish is called, done is called with the captured content.
+type recording struct {
+	io.ReadCloser
+
+	limit int
+	done  func(content string, truncated bool)
+
+	mutex    sync.Mutex
+	buffer   []byte
+	exceeded bool
+	eof      bool
+
+	once sync.Once
+}
+
+func (r *recording) Read(p []byte) (int, error) {
+	n, e := r.ReadCloser.Read(p)
+
+	r.mutex.Lock()
+
+	remaining := r.limit - len(r.buffer)
+	r.buffer = append(r.buffer, p[:min(n, max(remaining, 0))]...)
+	r.exceeded = r.exceeded || n > remaining
+	r.eof = r.eof || e == io.EOF
+
+	r.mutex.Unlock()
+
+	if e == io.EOF {
+		r.finish()
+	}
+
+	return n, e
+}
+
+func (r *recording) Close() error {
+	e := r.ReadCloser.Close()
+
+	r.finish()
+
+	return e
+}
+
+// finish calls done, once, with the content captured so far. Content that wasn't read to its end is truncated.
+func (r *recording) finish() {
+	if r == nil {
+		return
+	}
+
+	r.once.Do(func() {
+		r.mutex.Lock()
+
+		// Cutting the content at the limit may split a multibyte character.
+		content := strings.ToValidUTF8(string(r.buffer), "")
+		truncated := r.exceeded || !(r.eof)
+
+		r.mutex.Unlock()
+
+		r.done(content, truncated)
+	})
+}
+
+// capture returns a recording of the body, if capture is enabled and the body's media type is allowed, or nil. The
+// recording's content is emitted, in the context of span, as the given direction's body, e.g. "request"; afterward,
+// then is called, if not nil.
+func (c *Client) capture(ctx context.Context, span trace.Span, full, direction string, header http.Header, body io.ReadCloser, then func()) *recording {
+	policy := c.options.Capture
+	if policy == nil || body == nil || body == http.NoBody {
+		return nil
+	}
+
+	media, ok := policy.allowed(header.Get("Content-Type"))
+	if !(ok) {
+		return nil
+	}
+
+	return &recording{ReadCloser: body, limit: policy.Limit, done: func(content string, truncated bool) {
+		for _, redact := range policy.Redactors {
+			content = redact(media, content)
+		}
+
+		if policy.Logs {
+			slog.Log(ctx, c.options.Level, "HTTP Client Body Capture", slog.String("name", c.options.Name), slog.String("url", full), slog.String("direction", direction), slog.String("media-type", media), slog.String("body", content), slog.Bool("truncated", truncated))
+		} else {
+			span.AddEvent("http."+direction+".body", trace.WithAttributes(
+				attribute.String("http.body.media_type", media),
+				attribute.String("http.body.content", content),
+				attribute.Bool("http.body.truncated", truncated),
+			))
+		}
+
+		if then != nil {
+			then()
+		}
+	}}
+}
@@ -0,0 +1,237 @@
+package client_test
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/poly-gun/go-telemetry/client"
+)
+
+// event returns the named event of the span, if any.
+func event(span trace.ReadOnlySpan, name string) (trace.Event, bool) {
+	for _, event := range span.Events() {
+		if event.Name == name {
+			return event, true
+		}
+	}
+
+	return trace.Event{}, false
+}
+
+func TestCapture(t *testing.T) {
+	payload := strings.Repeat("0123456789", 10)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", r.URL.Query().Get("type"))
+		_, _ = io.WriteString(w, payload)
+	}))
+
+	defer server.Close()
+
+	capture := func(settings ...func(c *client.Capture)) func(o *client.Options) {
+		return func(o *client.Options) {
+			o.Capture = &client.Capture{Limit: 16}
+			for _, setting := range settings {
+				setting(o.Capture)
+			}
+		}
+	}
+
+	t.Run("Telemetry-Client-Capture-Limit", func(t *testing.T) {
+		recorder, _ := instrumented(t)
+
+		request, _ := http.NewRequest(http.MethodPost, server.URL+"?type=text/plain", strings.NewReader(`{"name":"request"}`))
+		request.Header.Set("Content-Type", "application/json")
+
+		response, e := client.New(capture()).Do(request)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Sending Request: %v", e)
+		}
+
+		received, _ := io.ReadAll(response.Body)
+		response.Body.Close()
+
+		if string(received) != payload {
+			t.Fatalf("Caller Received Partial Body: %q", received)
+		}
+
+		spans := recorder.Ended()
+		if len(spans) != 1 {
+			t.Fatalf("Unexpected Number of Spans: %d", len(spans))
+		}
+
+		for name, expected := range map[string]string{"http.request.body": `{"name":"request`, "http.response.body": payload[:16]} {
+			captured, ok := event(spans[0], name)
+			if !(ok) {
+				t.Fatalf("Event %s Not Recorded", name)
+			}
+
+			content, _ := value(captured.Attributes, "http.body.content")
+			truncated, _ := value(captured.Attributes, "http.body.truncated")
+			if content.AsString() != expected || !(truncated.AsBool()) {
+				t.Errorf("Unexpected %s - Content: %q, Truncated: %t", name, content.AsString(), truncated.AsBool())
+			}
+		}
+	})
+
+	t.Run("Telemetry-Client-Capture-Disallowed-Type", func(t *testing.T) {
+		recorder, _ := instrumented(t)
+
+		request, _ := http.NewRequest(http.MethodGet, server.URL+"?type=application/octet-stream", nil)
+
+		response, e := client.New(capture()).Do(request)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Sending Request: %v", e)
+		}
+
+		defer response.Body.Close()
+
+		// The span isn't awaiting the body's capture.
+		spans := recorder.Ended()
+		if len(spans) != 1 {
+			t.Fatalf("Unexpected Number of Spans: %d", len(spans))
+		}
+
+		if _, ok := event(spans[0], "http.response.body"); ok {
+			t.Error("Disallowed Content Type Captured")
+		}
+	})
+
+	t.Run("Telemetry-Client-Capture-Redactors", func(t *testing.T) {
+		recorder, _ := instrumented(t)
+
+		redactors := []func(media, content string) string{
+			func(_, content string) string { return strings.ReplaceAll(content, "0123", "[A]") },
+			func(_, content string) string { return strings.ReplaceAll(content, "[A]", "[B]") },
+		}
+
+		request, _ := http.NewRequest(http.MethodGet, server.URL+"?type=text/plain", nil)
+
+		response, e := client.New(capture(func(c *client.Capture) { c.Redactors = redactors })).Do(request)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Sending Request: %v", e)
+		}
+
+		_, _ = io.Copy(io.Discard, response.Body)
+		response.Body.Close()
+
+		captured, _ := event(recorder.Ended()[0], "http.response.body")
+		if content, _ := value(captured.Attributes, "http.body.content"); content.AsString() != "[B]456789[B]45" {
+			t.Errorf("Redactors Not Applied in Order: %q", content.AsString())
+		}
+	})
+
+	t.Run("Telemetry-Client-Capture-Logs", func(t *testing.T) {
+		recorder, _ := instrumented(t)
+
+		var buffer bytes.Buffer
+
+		logger := slog.Default()
+		defer slog.SetDefault(logger)
+
+		slog.SetDefault(slog.New(slog.NewJSONHandler(&buffer, nil)))
+
+		request, _ := http.NewRequest(http.MethodGet, server.URL+"?type=application/json", nil)
+
+		response, e := client.New(capture(func(c *client.Capture) { c.Logs = true })).Do(request)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Sending Request: %v", e)
+		}
+
+		// Logged captures don't defer the span's end.
+		if len(recorder.Ended()) != 1 {
+			t.Errorf("Span Not Ended Upon Response")
+		}
+
+		_, _ = io.Copy(io.Discard, response.Body)
+		response.Body.Close()
+
+		if !(strings.Contains(buffer.String(), `"msg":"HTTP Client Body Capture"`)) || !(strings.Contains(buffer.String(), `"body":"`+payload[:16]+`"`)) {
+			t.Errorf("Body Capture Not Logged:\n%s", buffer.String())
+		}
+
+		if _, ok := event(recorder.Ended()[0], "http.response.body"); ok {
+			t.Error("Unexpected Span Event in Logs Mode")
+		}
+	})
+
+	t.Run("Telemetry-Client-Capture-Span-End", func(t *testing.T) {
+		for _, tc := range []struct {
+			name   string
+			finish func(body io.ReadCloser)
+		}{
+			{"Close", func(body io.ReadCloser) { body.Close() }},
+			{"EOF", func(body io.ReadCloser) { _, _ = io.Copy(io.Discard, body) }},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				recorder, _ := instrumented(t)
+
+				request, _ := http.NewRequest(http.MethodGet, server.URL+"?type=text/plain", nil)
+
+				response, e := client.New(capture()).Do(request)
+				if e != nil {
+					t.Fatalf("Unexpected Error While Sending Request: %v", e)
+				}
+
+				defer response.Body.Close()
+
+				_, _ = response.Body.Read(make([]byte, 4))
+
+				if len(recorder.Ended()) != 0 {
+					t.Fatal("Span Ended Before the Body Was Finished")
+				}
+
+				tc.finish(response.Body)
+
+				if len(recorder.Ended()) != 1 {
+					t.Fatal("Span Not Ended Once the Body Was Finished")
+				}
+			})
+		}
+	})
+
+	t.Run("Telemetry-Client-Capture-Switching-Protocols", func(t *testing.T) {
+		recorder, _ := instrumented(t)
+
+		upgrade := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			connection, buffered, e := http.NewResponseController(w).Hijack()
+			if e != nil {
+				return
+			}
+
+			defer connection.Close()
+
+			_, _ = buffered.WriteString("HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: example\r\nContent-Type: text/plain\r\n\r\n")
+			_ = buffered.Flush()
+		}))
+
+		defer upgrade.Close()
+
+		request, _ := http.NewRequest(http.MethodGet, upgrade.URL, nil)
+		request.Header.Set("Connection", "Upgrade")
+		request.Header.Set("Upgrade", "example")
+
+		// The client's timeout would wrap the body; the transport is exercised directly.
+		response, e := client.New(capture()).RoundTrip(request)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Sending Request: %v", e)
+		}
+
+		defer response.Body.Close()
+
+		if _, ok := response.Body.(io.ReadWriteCloser); !(ok) || response.StatusCode != http.StatusSwitchingProtocols {
+			t.Errorf("Switching Protocols Response's Connection Wrapped: %T", response.Body)
+		}
+
+		if len(recorder.Ended()) != 1 {
+			t.Error("Span Not Ended Upon Switching Protocols")
+		}
+	})
+}
@@ -1,11 +1,23 @@
 package client
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -16,6 +28,25 @@ type Options struct {
 	Level   slog.Level
 
 	Attributes []attribute.KeyValue
+
+	// Namer returns the span name of a request. Defaults to [Name].
+	Namer func(r *http.Request) string
+
+	// Sensitive are the query parameters, matched case-insensitively, whose values are redacted from the URL recorded by
+	// spans and logs; credentials are always redacted. Defaults to "AWSAccessKeyId", "Signature", "sig" and "X-Goog-Signature".
+	Sensitive []string
+
+	// Retry is the retry policy of failed requests. Defaults to nil, making a single attempt per request.
+	Retry *Retry
+
+	// Breaker is the per-host circuit breaker's configuration. Defaults to nil, disabling the circuit breaker.
+	Breaker *Breaker
+
+	// Middleware wraps the client's base transport; see [Client.Use].
+	Middleware []func(http.RoundTripper) http.RoundTripper
+
+	// Capture enables capturing request and response bodies as span events or logs. Defaults to nil, disabling capture.
+	Capture *Capture
 }
 
 func (o *Options) defaults() *Options {
@@ -45,50 +76,321 @@ func (o *Options) defaults() *Options {
 		o.Attributes = make([]attribute.KeyValue, 0)
 	}
 
+	if o.Namer == nil {
+		o.Namer = Name
+	}
+
+	if o.Sensitive == nil {
+		o.Sensitive = slices.Clone(sensitive)
+	}
+
 	return o
 }
 
+// Client is an instrumented HTTP client; see [Client.Do]. A Client is also an [http.RoundTripper], instrumenting each
+// round trip of the [http.Client] using it; see [Transport].
 type Client struct {
 	client *http.Client
 
 	options *Options
+
+	instruments *instruments
+
+	// base is the transport sending the instrumented requests, and chain the base wrapped by the middleware; see [Client.Use].
+	base  http.RoundTripper
+	chain http.RoundTripper
+
+	// circuits are the circuit breakers of each host, keyed by the host and port of their requests' URL.
+	circuits sync.Map
 }
 
+// New returns a Client sending requests via [http.DefaultTransport].
 func New(settings ...func(o *Options)) *Client {
-	options := new(Options).defaults()
-	for _, setting := range settings {
-		if setting != nil {
-			setting(options)
-		}
+	return Transport(http.DefaultTransport, settings...)
+}
+
+// methods are the HTTP request methods known to the semantic conventions; others are reported as "_OTHER".
+var methods = map[string]bool{
+	http.MethodConnect: true,
+	http.MethodDelete:  true,
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPatch:   true,
+	http.MethodPost:    true,
+	http.MethodPut:     true,
+	http.MethodTrace:   true,
+}
+
+// attributes returns the stable HTTP client semantic convention attributes common to the spans and metrics of the request.
+func attributes(r *http.Request) []attribute.KeyValue {
+	attributes := make([]attribute.KeyValue, 0, 3)
+
+	method := r.Method
+	if !(methods[method]) {
+		method = "_OTHER"
+	}
+
+	attributes = append(attributes, semconv.HTTPRequestMethodKey.String(method))
+
+	if host := r.URL.Hostname(); host != "" {
+		attributes = append(attributes, semconv.ServerAddress(host))
 	}
 
-	return &Client{
-		client: &http.Client{
-			Timeout: options.Timeout,
-		},
-		options: options,
+	if value, ok := port(r.URL); ok {
+		attributes = append(attributes, semconv.ServerPort(value))
 	}
+
+	return attributes
 }
 
+// port returns the URL's port, defaulting to that of its scheme.
+func port(u *url.URL) (int, bool) {
+	port := u.Port()
+	if port == "" {
+		switch u.Scheme {
+		case "http":
+			port = "80"
+		case "https":
+			port = "443"
+		}
+	}
+
+	value, e := strconv.Atoi(port)
+
+	return value, e == nil
+}
+
+// Do sends the request via the client's [http.Client], following redirects and bounded by [Options.Timeout]; each round
+// trip is instrumented as described by [Client.RoundTrip].
 func (c *Client) Do(r *http.Request) (*http.Response, error) {
 	if c == nil {
-		*c = *New()
+		c = New()
 	}
 
+	return c.client.Do(r)
+}
+
+// RoundTrip sends the request within a client span, injecting the span's context and baggage into the request's headers
+// via the global propagator. The span records the response's status code; 4xx and 5xx responses, and transport errors,
+// mark it as failed.
+//
+// The request's duration, body sizes and the number of active requests are recorded via the global meter provider, along
+// with the attributes of the [Labeler] carried by the request's context, if any; see [ContextWithLabeler].
+//
+// If [Options.Retry] is set, failed attempts are retried according to its policy, each within its own child span of the
+// request's span. If [Options.Breaker] is set, requests to a host whose circuit is open fail fast, with an error matching
+// [ErrCircuitOpen].
+//
+// If [Options.Capture] is set, the request's and response's bodies are captured as they're read; when captured as span
+// events, the span ends once the response's body is exhausted or closed.
+func (c *Client) RoundTrip(r *http.Request) (*http.Response, error) {
 	ctx := r.Context()
-	kind := trace.WithSpanKind(trace.SpanKindClient)
-	links := trace.WithLinks(trace.LinkFromContext(ctx))
-	attributes := append(c.options.Attributes, attribute.String("url", r.URL.String()), attribute.String("method", r.Method))
-	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer(c.options.Name).Start(ctx, r.URL.String(), kind, trace.WithTimestamp(time.Now()), trace.WithAttributes(attributes...), links)
 
-	defer span.End()
+	// Ensure the request carries a labeler, so that attributes may be added to it while the request is in flight.
+	labeler, found := LabelerFromContext(ctx)
+	if !(found) {
+		ctx = ContextWithLabeler(ctx, labeler)
+	}
+
+	if c.options.Retry == nil {
+		return c.send(ctx, r, 0, trace.WithLinks(trace.LinkFromContext(ctx)))
+	}
+
+	return c.retry(ctx, r)
+}
+
+// start starts a client span of the request, given its sanitized URL.
+func (c *Client) start(ctx context.Context, r *http.Request, full string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	// Prefer the provider of the parent span, falling back to the global provider for root spans.
+	provider := trace.SpanFromContext(ctx).TracerProvider()
+	if !(trace.SpanContextFromContext(ctx).IsValid()) {
+		provider = otel.GetTracerProvider()
+	}
+
+	namer := c.options.Namer
+	if namer == nil {
+		namer = Name
+	}
+
+	common := attributes(r)
+
+	attributes := append(slices.Clone(c.options.Attributes), semconv.URLFull(full))
+	attributes = append(attributes, common...)
+	if !(methods[r.Method]) {
+		attributes = append(attributes, semconv.HTTPRequestMethodOriginal(r.Method))
+	}
+
+	options = append(options, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+
+	return provider.Tracer(c.options.Name).Start(ctx, namer(r), options...)
+}
+
+// send makes the nth attempt of the request within its own client span, recording its metrics.
+func (c *Client) send(ctx context.Context, r *http.Request, n int, options ...trace.SpanStartOption) (*http.Response, error) {
+	full := sanitize(r.URL, c.options.Sensitive)
+	start := time.Now()
+
+	ctx, span := c.start(ctx, r, full, append(options, trace.WithTimestamp(start))...)
+
+	// The span ends upon returning, unless it awaits the capture of its response's body as an event.
+	deferred := false
+	defer func() {
+		if !(deferred) {
+			span.End()
+		}
+	}()
+
+	// Clone the request, rather than modifying the caller's headers, carrying the client span's context.
+	original := r
+	r = r.Clone(ctx)
+
+	// The first attempt consumed the body; resends replay it.
+	if n > 0 && original.GetBody != nil {
+		body, e := original.GetBody()
+		if e != nil {
+			conclude(span, full, nil, e)
+
+			return nil, e
+		}
 
-	slog.Log(ctx, c.options.Level, "Log Message From HTTP Client Transport", slog.String("name", c.options.Name), slog.String("url", r.URL.String()))
+		r.Body = body
+	}
+
+	slog.Log(ctx, c.options.Level, "Log Message From HTTP Client Transport", slog.String("name", c.options.Name), slog.String("url", full))
 	for key, value := range c.options.Headers {
 		for _, v := range value {
 			r.Header.Add(key, v)
 		}
 	}
 
-	return c.client.Do(r)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(r.Header))
+
+	// Fail fast, without sending the request, while the host's circuit is open.
+	circuit := c.circuit(ctx, r)
+	trial, e := circuit.admit(ctx, r)
+	if e != nil {
+		// Round trippers close the request's body, even on errors.
+		if r.Body != nil {
+			_ = r.Body.Close()
+		}
+
+		conclude(span, full, nil, e)
+
+		return nil, e
+	}
+
+	// Capture the request's body as the transport reads it.
+	outgoing := c.capture(ctx, span, full, "request", r.Header, r.Body, nil)
+	if outgoing != nil {
+		r.Body = outgoing
+	}
+
+	common := append(attributes(r), semconv.URLScheme(r.URL.Scheme))
+
+	active := metric.WithAttributeSet(attribute.NewSet(common...))
+
+	c.instruments.active.Add(ctx, 1, active)
+
+	response, e := c.chain.RoundTrip(r)
+
+	c.instruments.active.Add(ctx, -1, active)
+
+	outgoing.finish()
+
+	circuit.settle(ctx, r, trial, response, e)
+
+	measured := response
+	measurements := common
+	if e != nil {
+		measured = nil
+	} else {
+		measurements = append(measurements, semconv.HTTPResponseStatusCode(response.StatusCode))
+	}
+
+	if kind := conclude(span, full, response, e); kind != "" {
+		measurements = append(measurements, semconv.ErrorTypeKey.String(kind))
+	}
+
+	labeler, _ := LabelerFromContext(ctx)
+
+	c.record(ctx, start, r, measured, append(measurements, labeler.Get()...))
+
+	// Capture the response's body as the caller reads it; switching protocols responses' bodies are connections.
+	if e == nil && response.StatusCode != http.StatusSwitchingProtocols {
+		var then func()
+		if c.options.Capture != nil && !(c.options.Capture.Logs) {
+			then = func() { span.End() }
+		}
+
+		if incoming := c.capture(ctx, span, full, "response", response.Header, response.Body, then); incoming != nil {
+			response.Body = incoming
+			deferred = then != nil
+		}
+	}
+
+	return response, e
+}
+
+// failure returns the semantic convention error type of the exchange, or an empty string if it succeeded: the type of a
+// transport error's underlying cause, or the status code of 4xx and 5xx responses.
+func failure(response *http.Response, e error) string {
+	if e != nil {
+		// Report the underlying error's type, rather than that of a [url.Error] wrapping it.
+		if u := (*url.Error)(nil); errors.As(e, &u) && u.Err != nil {
+			return fmt.Sprintf("%T", u.Err)
+		}
+
+		return fmt.Sprintf("%T", e)
+	}
+
+	if response.StatusCode >= 400 {
+		return strconv.Itoa(response.StatusCode)
+	}
+
+	return ""
+}
+
+// conclude records the outcome of the exchange on the span, given the request's sanitized URL, and returns its error type;
+// see [failure].
+func conclude(span trace.Span, full string, response *http.Response, e error) string {
+	kind := failure(response, e)
+
+	if e != nil {
+		// Errors wrapped in a [url.Error] include the request's URL; record the sanitized URL instead.
+		recorded := e
+		if u := (*url.Error)(nil); errors.As(e, &u) {
+			recorded = &url.Error{Op: u.Op, URL: full, Err: u.Err}
+		}
+
+		span.SetAttributes(semconv.ErrorTypeKey.String(kind))
+		span.RecordError(recorded)
+		span.SetStatus(codes.Error, recorded.Error())
+
+		return kind
+	}
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(response.StatusCode))
+	if kind != "" {
+		span.SetAttributes(semconv.ErrorTypeKey.String(kind))
+		span.SetStatus(codes.Error, http.StatusText(response.StatusCode))
+	}
+
+	return kind
+}
+
+// record measures the completed request; body sizes are only recorded when known from the request's or response's
+// Content-Length.
+func (c *Client) record(ctx context.Context, start time.Time, r *http.Request, response *http.Response, attributes []attribute.KeyValue) {
+	set := metric.WithAttributeSet(attribute.NewSet(attributes...))
+
+	c.instruments.duration.Record(ctx, time.Since(start).Seconds(), set)
+
+	if r.ContentLength >= 0 {
+		c.instruments.request.Record(ctx, r.ContentLength, set)
+	}
+
+	if response != nil && response.ContentLength >= 0 {
+		c.instruments.response.Record(ctx, response.ContentLength, set)
+	}
 }
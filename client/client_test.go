@@ -0,0 +1,138 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/poly-gun/go-telemetry/client"
+)
+
+func TestClient(t *testing.T) {
+	propagator := otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTextMapPropagator(propagator)
+	})
+
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	// received is the span context extracted from the last request's headers by the server.
+	var received trace.SpanContext
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = trace.SpanContextFromContext(propagation.TraceContext{}.Extract(r.Context(), propagation.HeaderCarrier(r.Header)))
+
+		status, _ := strconv.Atoi(r.URL.Query().Get("status"))
+
+		w.WriteHeader(status)
+	}))
+
+	defer server.Close()
+
+	address, _ := url.Parse(server.URL)
+	host := address.Hostname()
+	port, _ := strconv.Atoi(address.Port())
+
+	for _, tc := range []struct {
+		name   string
+		status int
+		kind   string
+		code   codes.Code
+	}{
+		{"OK", http.StatusOK, "", codes.Unset},
+		{"Not-Found", http.StatusNotFound, "404", codes.Error},
+		{"Internal-Server-Error", http.StatusInternalServerError, "500", codes.Error},
+	} {
+		t.Run("Telemetry-Client-Response-"+tc.name, func(t *testing.T) {
+			recorder, _ := instrumented(t)
+
+			full := server.URL + "/resource?status=" + strconv.Itoa(tc.status)
+
+			request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, full, nil)
+
+			response, e := client.New().Do(request)
+			if e != nil {
+				t.Fatalf("Unexpected Error While Sending Request: %v", e)
+			}
+
+			response.Body.Close()
+
+			spans := recorder.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("Unexpected Number of Spans: %d", len(spans))
+			}
+
+			span := spans[0]
+			if span.SpanKind() != trace.SpanKindClient {
+				t.Errorf("Unexpected Span Kind: %s", span.SpanKind())
+			}
+
+			if !(received.IsValid()) || received.TraceID() != span.SpanContext().TraceID() || received.SpanID() != span.SpanContext().SpanID() {
+				t.Errorf("Unexpected Propagated Span Context - Expected: %s, Received: %s", span.SpanContext().SpanID(), received.SpanID())
+			}
+
+			if request.Header.Get("Traceparent") != "" {
+				t.Error("Caller's Request Headers Modified")
+			}
+
+			expected := map[attribute.Key]any{
+				semconv.HTTPRequestMethodKey:      http.MethodGet,
+				semconv.URLFullKey:                full,
+				semconv.ServerAddressKey:          host,
+				semconv.ServerPortKey:             int64(port),
+				semconv.HTTPResponseStatusCodeKey: int64(tc.status),
+			}
+
+			for key, v := range expected {
+				if actual, _ := value(span.Attributes(), key); actual.AsInterface() != v {
+					t.Errorf("Unexpected %s - Expected: %v, Received: %v", key, v, actual.AsInterface())
+				}
+			}
+
+			kind, ok := value(span.Attributes(), semconv.ErrorTypeKey)
+			if (tc.kind == "" && ok) || kind.AsString() != tc.kind {
+				t.Errorf("Unexpected Error Type: %s", kind.Emit())
+			}
+
+			if span.Status().Code != tc.code {
+				t.Errorf("Unexpected Span Status: %+v", span.Status())
+			}
+		})
+	}
+
+	t.Run("Telemetry-Client-Transport-Error", func(t *testing.T) {
+		recorder, _ := instrumented(t)
+
+		unreachable := httptest.NewServer(http.NotFoundHandler())
+		unreachable.Close()
+
+		request, _ := http.NewRequest(http.MethodGet, unreachable.URL, nil)
+		if _, e := client.New().Do(request); e == nil {
+			t.Fatal("Expected Error From Unreachable Server")
+		}
+
+		span := recorder.Ended()[0]
+
+		if kind, _ := value(span.Attributes(), semconv.ErrorTypeKey); kind.AsString() != "*net.OpError" {
+			t.Errorf("Unexpected Error Type: %s", kind.Emit())
+		}
+
+		if _, ok := value(span.Attributes(), semconv.HTTPResponseStatusCodeKey); ok {
+			t.Error("Unexpected Status Code Without Response")
+		}
+
+		if span.Status().Code != codes.Error || len(span.Events()) == 0 || span.Events()[0].Name != "exception" {
+			t.Errorf("Transport Error Not Recorded - Status: %+v, Events: %+v", span.Status(), span.Events())
+		}
+	})
+}
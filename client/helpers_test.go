@@ -0,0 +1,69 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// instrumented installs global tracer and meter providers recording into memory, restoring the previous providers once
+// the test completes. Clients must be created afterward, as they create their instruments upon construction.
+func instrumented(t *testing.T) (*tracetest.SpanRecorder, *metric.ManualReader) {
+	t.Helper()
+
+	recorder, reader := tracetest.NewSpanRecorder(), metric.NewManualReader()
+
+	tracer, meter := otel.GetTracerProvider(), otel.GetMeterProvider()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(tracer)
+		otel.SetMeterProvider(meter)
+	})
+
+	otel.SetTracerProvider(trace.NewTracerProvider(trace.WithSpanProcessor(recorder)))
+	otel.SetMeterProvider(metric.NewMeterProvider(metric.WithReader(reader)))
+
+	return recorder, reader
+}
+
+// find returns the aggregation of the named metric collected by reader.
+func find(t *testing.T, reader *metric.ManualReader, name string) metricdata.Aggregation {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	if e := reader.Collect(context.Background(), &rm); e != nil {
+		t.Fatalf("Unexpected Error While Collecting Metrics: %v", e)
+	}
+
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name == name {
+				return m.Data
+			}
+		}
+	}
+
+	t.Fatalf("Metric %s Not Recorded", name)
+
+	return nil
+}
+
+// value returns the value of the attribute key within attributes.
+func value(attributes []attribute.KeyValue, key attribute.Key) (attribute.Value, bool) {
+	set := attribute.NewSet(attributes...)
+
+	return set.Value(key)
+}
+
+// transport adapts a function to an [http.RoundTripper].
+type transport func(r *http.Request) (*http.Response, error)
+
+func (f transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
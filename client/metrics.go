@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// Labeler collects custom attributes added to the metrics of a request; see [ContextWithLabeler].
+type Labeler struct {
+	mutex      sync.Mutex
+	attributes []attribute.KeyValue
+}
+
+// Add appends attributes to those recorded by the request's metrics.
+func (l *Labeler) Add(attributes ...attribute.KeyValue) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.attributes = append(l.attributes, attributes...)
+}
+
+// Get returns a copy of the labeler's attributes.
+func (l *Labeler) Get() []attribute.KeyValue {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	attributes := make([]attribute.KeyValue, len(l.attributes))
+	copy(attributes, l.attributes)
+
+	return attributes
+}
+
+type labeler struct{}
+
+// ContextWithLabeler returns a copy of ctx carrying the labeler; the attributes added to it, up to the request's
+// completion, are recorded by the metrics of requests made with the context.
+func ContextWithLabeler(ctx context.Context, l *Labeler) context.Context {
+	return context.WithValue(ctx, labeler{}, l)
+}
+
+// LabelerFromContext returns the labeler carried by ctx, or a new, empty labeler and false if there's none.
+func LabelerFromContext(ctx context.Context) (*Labeler, bool) {
+	l, ok := ctx.Value(labeler{}).(*Labeler)
+	if !(ok) {
+		l = &Labeler{}
+	}
+
+	return l, ok
+}
+
+// boundaries are the explicit bucket boundaries of the duration histogram recommended by the semantic conventions.
+var boundaries = []float64{0.005, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2.5, 5, 7.5, 10}
+
+// instruments are the stable HTTP client semantic convention metrics.
+type instruments struct {
+	duration metric.Float64Histogram
+	request  metric.Int64Histogram
+	response metric.Int64Histogram
+	active   metric.Int64UpDownCounter
+	retries  metric.Int64Counter
+	state    metric.Int64Gauge
+}
+
+// instrument creates the client's instruments from the global meter provider. Instruments failing to be created are
+// reported to the global error handler, and replaced by no-op instruments.
+func instrument(name string) *instruments {
+	meter := otel.GetMeterProvider().Meter(name)
+
+	duration, e := meter.Float64Histogram(semconv.HTTPClientRequestDurationName,
+		metric.WithUnit(semconv.HTTPClientRequestDurationUnit),
+		metric.WithDescription(semconv.HTTPClientRequestDurationDescription),
+		metric.WithExplicitBucketBoundaries(boundaries...),
+	)
+	if e != nil {
+		otel.Handle(e)
+
+		duration = noop.Float64Histogram{}
+	}
+
+	request, e := meter.Int64Histogram(semconv.HTTPClientRequestBodySizeName,
+		metric.WithUnit(semconv.HTTPClientRequestBodySizeUnit),
+		metric.WithDescription(semconv.HTTPClientRequestBodySizeDescription),
+	)
+	if e != nil {
+		otel.Handle(e)
+
+		request = noop.Int64Histogram{}
+	}
+
+	response, e := meter.Int64Histogram(semconv.HTTPClientResponseBodySizeName,
+		metric.WithUnit(semconv.HTTPClientResponseBodySizeUnit),
+		metric.WithDescription(semconv.HTTPClientResponseBodySizeDescription),
+	)
+	if e != nil {
+		otel.Handle(e)
+
+		response = noop.Int64Histogram{}
+	}
+
+	active, e := meter.Int64UpDownCounter(semconv.HTTPClientActiveRequestsName,
+		metric.WithUnit(semconv.HTTPClientActiveRequestsUnit),
+		metric.WithDescription(semconv.HTTPClientActiveRequestsDescription),
+	)
+	if e != nil {
+		otel.Handle(e)
+
+		active = noop.Int64UpDownCounter{}
+	}
+
+	retries, e := meter.Int64Counter("http.client.request.retries",
+		metric.WithUnit("{retry}"),
+		metric.WithDescription("Number of HTTP client request retries."),
+	)
+	if e != nil {
+		otel.Handle(e)
+
+		retries = noop.Int64Counter{}
+	}
+
+	state, e := meter.Int64Gauge("http.client.circuit_breaker.state",
+		metric.WithUnit("{state}"),
+		metric.WithDescription("State of the HTTP client's circuit breaker per host: 0 (closed), 1 (open) or 2 (half-open)."),
+	)
+	if e != nil {
+		otel.Handle(e)
+
+		state = noop.Int64Gauge{}
+	}
+
+	return &instruments{duration: duration, request: request, response: response, active: active, retries: retries, state: state}
+}
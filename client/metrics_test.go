@@ -0,0 +1,142 @@
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/poly-gun/go-telemetry/client"
+)
+
+func TestMetrics(t *testing.T) {
+	// received signals the request's arrival; the server responds once release is closed.
+	received, release := make(chan struct{}), make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+
+		received <- struct{}{}
+		<-release
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, "created")
+	}))
+
+	defer server.Close()
+
+	address, _ := url.Parse(server.URL)
+	port, _ := strconv.Atoi(address.Port())
+
+	t.Run("Telemetry-Client-Metrics-Instruments", func(t *testing.T) {
+		_, reader := instrumented(t)
+
+		c := client.New()
+
+		labeler := &client.Labeler{}
+		labeler.Add(attribute.String("operation", "create"))
+
+		request, _ := http.NewRequestWithContext(client.ContextWithLabeler(context.Background(), labeler), http.MethodPost, server.URL, strings.NewReader("payload"))
+
+		done := make(chan error, 1)
+		go func() {
+			response, e := c.Do(request)
+			if e == nil {
+				_, _ = io.Copy(io.Discard, response.Body)
+				response.Body.Close()
+			}
+
+			done <- e
+		}()
+
+		<-received
+
+		// While the request is in flight, it's active, and attributes may still be added.
+		sum, ok := find(t, reader, semconv.HTTPClientActiveRequestsName).(metricdata.Sum[int64])
+		if !(ok) || len(sum.DataPoints) != 1 || sum.DataPoints[0].Value != 1 {
+			t.Errorf("Unexpected Active Requests While in Flight: %+v", sum)
+		}
+
+		labeler.Add(attribute.String("tenant", "example"))
+
+		close(release)
+
+		if e := <-done; e != nil {
+			t.Fatalf("Unexpected Error While Sending Request: %v", e)
+		}
+
+		expected := attribute.NewSet(
+			semconv.HTTPRequestMethodPost,
+			semconv.ServerAddress(address.Hostname()),
+			semconv.ServerPort(port),
+			semconv.URLScheme("http"),
+			semconv.HTTPResponseStatusCode(http.StatusCreated),
+			attribute.String("operation", "create"),
+			attribute.String("tenant", "example"),
+		)
+
+		duration, ok := find(t, reader, semconv.HTTPClientRequestDurationName).(metricdata.Histogram[float64])
+		if !(ok) || len(duration.DataPoints) != 1 || duration.DataPoints[0].Count != 1 {
+			t.Fatalf("Unexpected Duration Histogram: %+v", duration)
+		}
+
+		if !(duration.DataPoints[0].Attributes.Equals(&expected)) {
+			t.Errorf("Unexpected Duration Attributes: %s", duration.DataPoints[0].Attributes.Encoded(attribute.DefaultEncoder()))
+		}
+
+		for name, size := range map[string]int64{semconv.HTTPClientRequestBodySizeName: 7, semconv.HTTPClientResponseBodySizeName: 7} {
+			histogram, ok := find(t, reader, name).(metricdata.Histogram[int64])
+			if !(ok) || len(histogram.DataPoints) != 1 || histogram.DataPoints[0].Sum != size {
+				t.Fatalf("Unexpected %s Histogram: %+v", name, histogram)
+			}
+
+			if !(histogram.DataPoints[0].Attributes.Equals(&expected)) {
+				t.Errorf("Unexpected %s Attributes: %s", name, histogram.DataPoints[0].Attributes.Encoded(attribute.DefaultEncoder()))
+			}
+		}
+
+		sum, ok = find(t, reader, semconv.HTTPClientActiveRequestsName).(metricdata.Sum[int64])
+		if !(ok) || len(sum.DataPoints) != 1 || sum.DataPoints[0].Value != 0 {
+			t.Fatalf("Unexpected Active Requests Once Completed: %+v", sum)
+		}
+
+		// Active requests are only attributed with what's known before sending the request.
+		common := attribute.NewSet(semconv.HTTPRequestMethodPost, semconv.ServerAddress(address.Hostname()), semconv.ServerPort(port), semconv.URLScheme("http"))
+		if !(sum.DataPoints[0].Attributes.Equals(&common)) {
+			t.Errorf("Unexpected Active Requests Attributes: %s", sum.DataPoints[0].Attributes.Encoded(attribute.DefaultEncoder()))
+		}
+	})
+
+	t.Run("Telemetry-Client-Metrics-Transport-Error", func(t *testing.T) {
+		_, reader := instrumented(t)
+
+		unreachable := httptest.NewServer(http.NotFoundHandler())
+		unreachable.Close()
+
+		request, _ := http.NewRequest(http.MethodGet, unreachable.URL, nil)
+		if _, e := client.New().Do(request); e == nil {
+			t.Fatal("Expected Error From Unreachable Server")
+		}
+
+		duration, ok := find(t, reader, semconv.HTTPClientRequestDurationName).(metricdata.Histogram[float64])
+		if !(ok) || len(duration.DataPoints) != 1 {
+			t.Fatalf("Unexpected Duration Histogram: %+v", duration)
+		}
+
+		if kind, _ := duration.DataPoints[0].Attributes.Value(semconv.ErrorTypeKey); kind.AsString() != "*net.OpError" {
+			t.Errorf("Unexpected Error Type: %s", kind.Emit())
+		}
+
+		if _, ok := duration.DataPoints[0].Attributes.Value(semconv.HTTPResponseStatusCodeKey); ok {
+			t.Error("Unexpected Status Code Without Response")
+		}
+	})
+}
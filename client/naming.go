@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type route struct{}
+
+// Route returns a copy of ctx carrying the low-cardinality route template of requests made with it, e.g. "/users/{id}",
+// used by the default span [Options.Namer].
+func Route(ctx context.Context, template string) context.Context {
+	return context.WithValue(ctx, route{}, template)
+}
+
+// Template returns the route template carried by ctx, if any; see [Route].
+func Template(ctx context.Context) (string, bool) {
+	template, ok := ctx.Value(route{}).(string)
+
+	return template, ok && template != ""
+}
+
+// Name is the default span [Options.Namer], returning "{method} {route-template}", or "{method}" if the request's context
+// carries no template; see [Route]. Methods unknown to the semantic conventions are named "HTTP".
+func Name(r *http.Request) string {
+	method := r.Method
+	if !(methods[method]) {
+		method = "HTTP"
+	}
+
+	if template, ok := Template(r.Context()); ok {
+		return method + " " + template
+	}
+
+	return method
+}
+
+// sensitive are the query parameters redacted by default, as recommended by the semantic conventions.
+var sensitive = []string{"AWSAccessKeyId", "Signature", "sig", "X-Goog-Signature"}
+
+// sanitize returns the URL with its credentials and the values of sensitive query parameters (matched case-insensitively)
+// replaced by "REDACTED".
+func sanitize(u *url.URL, parameters []string) string {
+	sanitized := *u
+
+	if u.User != nil {
+		if _, ok := u.User.Password(); ok {
+			sanitized.User = url.UserPassword("REDACTED", "REDACTED")
+		} else {
+			sanitized.User = url.User("REDACTED")
+		}
+	}
+
+	if u.RawQuery != "" {
+		pairs := strings.Split(u.RawQuery, "&")
+		for i, pair := range pairs {
+			key, _, _ := strings.Cut(pair, "=")
+			if name, e := url.QueryUnescape(key); e == nil {
+				for _, parameter := range parameters {
+					if strings.EqualFold(name, parameter) {
+						pairs[i] = key + "=REDACTED"
+						break
+					}
+				}
+			}
+		}
+
+		sanitized.RawQuery = strings.Join(pairs, "&")
+	}
+
+	return sanitized.String()
+}
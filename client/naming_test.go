@@ -0,0 +1,115 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/poly-gun/go-telemetry/client"
+)
+
+func TestNaming(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	t.Run("Telemetry-Client-Span-Names", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			method   string
+			template string
+			namer    func(r *http.Request) string
+			expected string
+		}{
+			{"Method", http.MethodGet, "", nil, "GET"},
+			{"Route-Template", http.MethodPost, "/users/{id}", nil, "POST /users/{id}"},
+			{"Unknown-Method", "PURGE", "", nil, "HTTP"},
+			{"Unknown-Method-Route-Template", "PURGE", "/cache/{key}", nil, "HTTP /cache/{key}"},
+			{"Custom-Namer", http.MethodGet, "/users/{id}", func(r *http.Request) string { return "users.get" }, "users.get"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				recorder, _ := instrumented(t)
+
+				ctx := context.Background()
+				if tc.template != "" {
+					ctx = client.Route(ctx, tc.template)
+				}
+
+				request, _ := http.NewRequestWithContext(ctx, tc.method, server.URL+"/users/1", nil)
+
+				c := client.New(func(o *client.Options) {
+					o.Namer = tc.namer
+				})
+
+				response, e := c.Do(request)
+				if e != nil {
+					t.Fatalf("Unexpected Error While Sending Request: %v", e)
+				}
+
+				response.Body.Close()
+
+				if name := recorder.Ended()[0].Name(); name != tc.expected {
+					t.Errorf("Unexpected Span Name - Expected: %s, Received: %s", tc.expected, name)
+				}
+			})
+		}
+	})
+
+	t.Run("Telemetry-Client-URL-Sanitization", func(t *testing.T) {
+		// The URLs' "{host}" is replaced by the server's address.
+		for _, tc := range []struct {
+			name      string
+			url       string
+			sensitive []string
+			expected  string
+		}{
+			{"Password", "http://user:secret@{host}/path", nil, "http://REDACTED:REDACTED@{host}/path"},
+			{"Username", "http://token@{host}/path", nil, "http://REDACTED@{host}/path"},
+			{"Case-Insensitive-Parameter", "http://{host}/path?signature=abc&id=1", nil, "http://{host}/path?signature=REDACTED&id=1"},
+			{"Percent-Encoded-Parameter", "http://{host}/path?X%2DGoog%2DSignature=abc", nil, "http://{host}/path?X%2DGoog%2DSignature=REDACTED"},
+			{"Repeated-Parameter", "http://{host}/path?sig=a&sig=b", nil, "http://{host}/path?sig=REDACTED&sig=REDACTED"},
+			{"Custom-Parameters", "http://{host}/path?token=abc&sig=def", []string{"Token"}, "http://{host}/path?token=REDACTED&sig=def"},
+			{"Unchanged", "http://{host}/path?id=1#fragment", nil, "http://{host}/path?id=1#fragment"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				recorder, _ := instrumented(t)
+
+				address := strings.ReplaceAll(tc.url, "{host}", host)
+				expected := strings.ReplaceAll(tc.expected, "{host}", host)
+
+				request, _ := http.NewRequest(http.MethodGet, address, nil)
+
+				// Settings apply after the defaults; only replace the sensitive parameters when given.
+				c := client.New(func(o *client.Options) {
+					if tc.sensitive != nil {
+						o.Sensitive = tc.sensitive
+					}
+				})
+
+				response, e := c.Do(request)
+				if e != nil {
+					t.Fatalf("Unexpected Error While Sending Request: %v", e)
+				}
+
+				response.Body.Close()
+
+				full, _ := value(recorder.Ended()[0].Attributes(), semconv.URLFullKey)
+				if full.AsString() != expected {
+					t.Errorf("Unexpected URL - Expected: %s, Received: %s", expected, full.AsString())
+				}
+
+				if request.URL.String() != address {
+					t.Errorf("Caller's Request URL Modified: %s", request.URL)
+				}
+			})
+		}
+	})
+}
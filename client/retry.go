@@ -0,0 +1,236 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Retry represents the retry policy of a [Client]; see [Options.Retry].
+type Retry struct {
+	// Attempts is the maximum number of attempts, including the first. Defaults to 3.
+	Attempts int
+
+	// Backoff is the delay before the first retry, doubled before each subsequent retry. Defaults to 100 milliseconds.
+	Backoff time.Duration
+
+	// Maximum bounds the delay before each retry; a Retry-After header requesting a longer delay ends the retries. Defaults
+	// to 10 seconds.
+	Maximum time.Duration
+
+	// Jitter is the fraction of each delay that's randomized, between 0 and 1. Defaults to 0.5; a negative value disables jitter.
+	Jitter float64
+
+	// Statuses are the response status codes that are retried. Defaults to 408, 429, 502, 503 and 504.
+	Statuses []int
+
+	// Retryable reports whether a transport error is retried. Defaults to [Transient].
+	Retryable func(e error) bool
+
+	// Methods are the request methods that are retried. Defaults to the idempotent methods: GET, HEAD, OPTIONS, TRACE, PUT
+	// and DELETE. Requests carrying an Idempotency-Key or X-Idempotency-Key header are retried regardless of their method.
+	Methods []string
+}
+
+func (o *Retry) defaults() {
+	if o.Attempts <= 0 {
+		o.Attempts = 3
+	}
+
+	if o.Backoff <= 0 {
+		o.Backoff = 100 * time.Millisecond
+	}
+
+	if o.Maximum <= 0 {
+		o.Maximum = 10 * time.Second
+	}
+
+	if o.Jitter == 0 {
+		o.Jitter = 0.5
+	}
+
+	if o.Jitter > 1 {
+		o.Jitter = 1
+	}
+
+	if o.Statuses == nil {
+		o.Statuses = []int{http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+	}
+
+	if o.Retryable == nil {
+		o.Retryable = Transient
+	}
+
+	if o.Methods == nil {
+		o.Methods = []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete}
+	}
+}
+
+// eligible reports whether the request may be retried: its method is retryable, and its body, if any, can be replayed.
+func (o *Retry) eligible(r *http.Request) bool {
+	if r.Body != nil && r.Body != http.NoBody && r.GetBody == nil {
+		return false
+	}
+
+	if _, ok := r.Header["Idempotency-Key"]; ok {
+		return true
+	}
+
+	if _, ok := r.Header["X-Idempotency-Key"]; ok {
+		return true
+	}
+
+	return slices.Contains(o.Methods, r.Method)
+}
+
+// delay returns the delay before retrying the nth (zero-based) attempt, given its outcome, and whether it's retried.
+func (o *Retry) delay(n int, response *http.Response, e error) (time.Duration, bool) {
+	if n+1 >= o.Attempts {
+		return 0, false
+	}
+
+	if e != nil && !(o.Retryable(e)) {
+		return 0, false
+	}
+
+	if e == nil && !(slices.Contains(o.Statuses, response.StatusCode)) {
+		return 0, false
+	}
+
+	delay := o.Backoff
+	for i := 0; i < n && delay < o.Maximum; i++ {
+		delay *= 2
+	}
+
+	delay = min(delay, o.Maximum)
+	if o.Jitter > 0 {
+		delay -= time.Duration(rand.Float64() * o.Jitter * float64(delay))
+	}
+
+	if e == nil {
+		if after, ok := after(response.Header.Get("Retry-After")); ok {
+			if after > o.Maximum {
+				return 0, false
+			}
+
+			delay = max(delay, after)
+		}
+	}
+
+	return delay, true
+}
+
+// after parses a Retry-After header value, given either in seconds or as an HTTP date.
+func after(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, e := strconv.Atoi(value); e == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, e := http.ParseTime(value); e == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// Transient reports whether the transport error is likely to be resolved by retrying: timeouts, refused and reset
+// connections, and connections closed before a response was received. It's the default [Retry.Retryable].
+func Transient(e error) bool {
+	if errors.Is(e, context.Canceled) {
+		return false
+	}
+
+	if verification := (*tls.CertificateVerificationError)(nil); errors.As(e, &verification) {
+		return false
+	}
+
+	if errors.Is(e, syscall.ECONNREFUSED) || errors.Is(e, syscall.ECONNRESET) || errors.Is(e, syscall.EPIPE) {
+		return true
+	}
+
+	if errors.Is(e, io.EOF) || errors.Is(e, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var network net.Error
+
+	return errors.As(e, &network) && network.Timeout()
+}
+
+// retry sends the request within a client span, making each attempt within its own child span until one succeeds or the
+// retry policy is exhausted. The outcome of the last attempt is returned.
+func (c *Client) retry(ctx context.Context, r *http.Request) (*http.Response, error) {
+	policy := c.options.Retry
+	full := sanitize(r.URL, c.options.Sensitive)
+
+	ctx, span := c.start(ctx, r, full, trace.WithLinks(trace.LinkFromContext(ctx)))
+
+	defer span.End()
+
+	eligible := policy.eligible(r)
+
+	for n := 0; ; n++ {
+		var options []trace.SpanStartOption
+		if n > 0 {
+			options = append(options, trace.WithAttributes(semconv.HTTPRequestResendCount(n)))
+		}
+
+		response, e := c.send(ctx, r, n, options...)
+
+		delay, retry := policy.delay(n, response, e)
+		if !(eligible && retry) || ctx.Err() != nil {
+			conclude(span, full, response, e)
+
+			return response, e
+		}
+
+		reason := failure(response, e)
+
+		// Drain a bounded portion of the discarded response's body, allowing its connection to be reused.
+		if e == nil {
+			_, _ = io.CopyN(io.Discard, response.Body, 4<<10)
+			_ = response.Body.Close()
+		}
+
+		labeler, _ := LabelerFromContext(ctx)
+
+		measurements := append(attributes(r), semconv.URLScheme(r.URL.Scheme), semconv.ErrorTypeKey.String(reason))
+		measurements = append(measurements, labeler.Get()...)
+
+		c.instruments.retries.Add(ctx, 1, metric.WithAttributeSet(attribute.NewSet(measurements...)))
+
+		slog.Log(ctx, c.options.Level, "Retrying HTTP Client Request", slog.String("name", c.options.Name), slog.String("url", full), slog.Int("attempt", n+1), slog.String("reason", reason), slog.Duration("delay", delay))
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			e := ctx.Err()
+
+			conclude(span, full, nil, e)
+
+			return nil, e
+		case <-timer.C:
+		}
+	}
+}
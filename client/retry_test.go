@@ -0,0 +1,263 @@
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/poly-gun/go-telemetry/client"
+)
+
+// flaky is a server responding with the given status, and headers, until its number of failures is exhausted.
+type flaky struct {
+	mutex    sync.Mutex
+	failures int
+	status   int
+	header   http.Header
+	bodies   []string
+}
+
+func (f *flaky) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.bodies = append(f.bodies, string(body))
+
+	if f.failures != 0 {
+		f.failures--
+
+		for key, values := range f.header {
+			w.Header()[key] = values
+		}
+
+		w.WriteHeader(f.status)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// attempts returns the number of requests received by the server.
+func (f *flaky) attempts() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return len(f.bodies)
+}
+
+func TestRetry(t *testing.T) {
+	retry := func(settings ...func(r *client.Retry)) func(o *client.Options) {
+		return func(o *client.Options) {
+			o.Retry = &client.Retry{Backoff: time.Millisecond}
+			for _, setting := range settings {
+				setting(o.Retry)
+			}
+		}
+	}
+
+	t.Run("Telemetry-Client-Retry-Attempts", func(t *testing.T) {
+		recorder, reader := instrumented(t)
+
+		handler := &flaky{failures: 2, status: http.StatusServiceUnavailable}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+
+		response, e := client.New(retry()).Do(request)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Sending Request: %v", e)
+		}
+
+		response.Body.Close()
+
+		if response.StatusCode != http.StatusOK || handler.attempts() != 3 {
+			t.Fatalf("Unexpected Outcome - Status: %d, Attempts: %d", response.StatusCode, handler.attempts())
+		}
+
+		spans := recorder.Ended()
+		if len(spans) != 4 {
+			t.Fatalf("Unexpected Number of Spans: %d", len(spans))
+		}
+
+		// Attempts end before the request's span.
+		parent := spans[3]
+		for i, span := range spans[:3] {
+			if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+				t.Errorf("Attempt %d Isn't a Child of the Request's Span", i)
+			}
+
+			count, ok := value(span.Attributes(), semconv.HTTPRequestResendCountKey)
+			if (i == 0 && ok) || (i > 0 && count.AsInt64() != int64(i)) {
+				t.Errorf("Unexpected Resend Count of Attempt %d: %v", i, count.Emit())
+			}
+		}
+
+		sum, ok := find(t, reader, "http.client.request.retries").(metricdata.Sum[int64])
+		if !(ok) || len(sum.DataPoints) != 1 || sum.DataPoints[0].Value != 2 {
+			t.Fatalf("Unexpected Retry Counter: %+v", sum)
+		}
+
+		if kind, _ := sum.DataPoints[0].Attributes.Value(semconv.ErrorTypeKey); kind.AsString() != "503" {
+			t.Errorf("Unexpected Retry Reason: %s", kind.Emit())
+		}
+	})
+
+	t.Run("Telemetry-Client-Retry-Transport-Errors", func(t *testing.T) {
+		recorder, _ := instrumented(t)
+
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		request, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		if _, e := client.New(retry()).Do(request); e == nil {
+			t.Fatal("Expected Error From Unreachable Server")
+		}
+
+		if spans := recorder.Ended(); len(spans) != 4 {
+			t.Errorf("Unexpected Number of Spans: %d", len(spans))
+		}
+	})
+
+	t.Run("Telemetry-Client-Retry-After-Seconds", func(t *testing.T) {
+		instrumented(t)
+
+		handler := &flaky{failures: 1, status: http.StatusTooManyRequests, header: http.Header{"Retry-After": {"1"}}}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		request, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+
+		start := time.Now()
+		response, e := client.New(retry()).Do(request)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Sending Request: %v", e)
+		}
+
+		response.Body.Close()
+
+		if response.StatusCode != http.StatusOK || time.Since(start) < time.Second {
+			t.Errorf("Retry-After Not Honored - Status: %d, Elapsed: %s", response.StatusCode, time.Since(start))
+		}
+	})
+
+	t.Run("Telemetry-Client-Retry-After-Date", func(t *testing.T) {
+		instrumented(t)
+
+		date := time.Now().Add(2 * time.Second).UTC().Format(http.TimeFormat)
+
+		handler := &flaky{failures: 1, status: http.StatusServiceUnavailable, header: http.Header{"Retry-After": {date}}}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		request, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+
+		start := time.Now()
+		response, e := client.New(retry()).Do(request)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Sending Request: %v", e)
+		}
+
+		response.Body.Close()
+
+		// The date's precision is a second.
+		if response.StatusCode != http.StatusOK || time.Since(start) < time.Second {
+			t.Errorf("Retry-After Not Honored - Status: %d, Elapsed: %s", response.StatusCode, time.Since(start))
+		}
+	})
+
+	t.Run("Telemetry-Client-Retry-After-Exceeding-Maximum", func(t *testing.T) {
+		instrumented(t)
+
+		handler := &flaky{failures: 1, status: http.StatusServiceUnavailable, header: http.Header{"Retry-After": {"60"}}}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		request, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+
+		response, e := client.New(retry(func(r *client.Retry) { r.Maximum = time.Second })).Do(request)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Sending Request: %v", e)
+		}
+
+		response.Body.Close()
+
+		if response.StatusCode != http.StatusServiceUnavailable || handler.attempts() != 1 {
+			t.Errorf("Unexpected Outcome - Status: %d, Attempts: %d", response.StatusCode, handler.attempts())
+		}
+	})
+
+	t.Run("Telemetry-Client-Retry-Idempotency", func(t *testing.T) {
+		instrumented(t)
+
+		for _, tc := range []struct {
+			name     string
+			key      string
+			attempts int
+		}{
+			{"Non-Idempotent", "", 1},
+			{"Idempotency-Key", "b7c1e0", 3},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				handler := &flaky{failures: -1, status: http.StatusServiceUnavailable}
+				server := httptest.NewServer(handler)
+				defer server.Close()
+
+				request, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("payload"))
+				if tc.key != "" {
+					request.Header.Set("Idempotency-Key", tc.key)
+				}
+
+				response, e := client.New(retry()).Do(request)
+				if e != nil {
+					t.Fatalf("Unexpected Error While Sending Request: %v", e)
+				}
+
+				response.Body.Close()
+
+				if handler.attempts() != tc.attempts {
+					t.Fatalf("Unexpected Number of Attempts: %d", handler.attempts())
+				}
+
+				// Resends replay the body via GetBody.
+				for i, body := range handler.bodies {
+					if body != "payload" {
+						t.Errorf("Unexpected Body of Attempt %d: %q", i, body)
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("Telemetry-Client-Retry-Non-Replayable-Body", func(t *testing.T) {
+		instrumented(t)
+
+		handler := &flaky{failures: -1, status: http.StatusServiceUnavailable}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		request, _ := http.NewRequest(http.MethodPut, server.URL, io.NopCloser(strings.NewReader("payload")))
+
+		response, e := client.New(retry()).Do(request)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Sending Request: %v", e)
+		}
+
+		response.Body.Close()
+
+		if handler.attempts() != 1 {
+			t.Errorf("Unexpected Number of Attempts: %d", handler.attempts())
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"net/http"
+	"slices"
+)
+
+// Transport returns a Client instrumenting the round trips sent via base, for use as the [http.RoundTripper] of any
+// [http.Client], including those of third-party SDKs. If base is nil, [http.DefaultTransport] is used.
+//
+//	sdk := &http.Client{Transport: client.Transport(http.DefaultTransport)}
+func Transport(base http.RoundTripper, settings ...func(o *Options)) *Client {
+	options := new(Options).defaults()
+	for _, setting := range settings {
+		if setting != nil {
+			setting(options)
+		}
+	}
+
+	if options.Retry != nil {
+		retry := *options.Retry
+		retry.defaults()
+
+		options.Retry = &retry
+	}
+
+	if options.Breaker != nil {
+		breaker := *options.Breaker
+		breaker.defaults()
+
+		options.Breaker = &breaker
+	}
+
+	if options.Capture != nil {
+		capture := *options.Capture
+		capture.defaults()
+
+		options.Capture = &capture
+	}
+
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	c := &Client{
+		options:     options,
+		instruments: instrument(options.Name),
+		base:        base,
+	}
+
+	options.Middleware = slices.Clone(options.Middleware)
+
+	c.Use()
+
+	c.client = &http.Client{
+		Transport: c,
+		Timeout:   options.Timeout,
+	}
+
+	return c
+}
+
+// Use appends middleware to the client's chain, and returns the client. The middleware wraps the base transport within
+// the instrumentation, in the order given, the first being outermost; each wrapped transport therefore runs within the
+// client span of every attempt, and its errors are recorded. Use must be called before the client sends any requests.
+func (c *Client) Use(middleware ...func(http.RoundTripper) http.RoundTripper) *Client {
+	c.options.Middleware = append(c.options.Middleware, middleware...)
+
+	c.chain = c.base
+	for i := len(c.options.Middleware) - 1; i >= 0; i-- {
+		c.chain = c.options.Middleware[i](c.chain)
+	}
+
+	return c
+}
+
+// HTTP returns a copy of the client's [http.Client], configured with its [Options.Timeout] and using the client as its
+// transport.
+func (c *Client) HTTP() *http.Client {
+	client := *c.client
+
+	return &client
+}
@@ -0,0 +1,130 @@
+package client_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/poly-gun/go-telemetry/client"
+)
+
+func TestTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	defer server.Close()
+
+	t.Run("Telemetry-Client-Middleware-Order", func(t *testing.T) {
+		recorder, _ := instrumented(t)
+
+		var order []string
+		var spans []trace.SpanContext
+
+		middleware := func(name string) func(http.RoundTripper) http.RoundTripper {
+			return func(next http.RoundTripper) http.RoundTripper {
+				return transport(func(r *http.Request) (*http.Response, error) {
+					order = append(order, name)
+					spans = append(spans, trace.SpanContextFromContext(r.Context()))
+
+					response, e := next.RoundTrip(r)
+
+					order = append(order, "/"+name)
+
+					return response, e
+				})
+			}
+		}
+
+		c := client.New(func(o *client.Options) {
+			o.Middleware = append(o.Middleware, middleware("options"))
+		}).Use(middleware("first"), middleware("second"))
+
+		request, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+
+		response, e := c.Do(request)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Sending Request: %v", e)
+		}
+
+		response.Body.Close()
+
+		expected := "options first second /second /first /options"
+		if strings.Join(order, " ") != expected {
+			t.Errorf("Unexpected Middleware Order - Expected: %s, Received: %s", expected, strings.Join(order, " "))
+		}
+
+		span := recorder.Ended()[0].SpanContext()
+		for i, sc := range spans {
+			if !(sc.IsValid()) || sc.SpanID() != span.SpanID() {
+				t.Errorf("Middleware %d Not Within the Client Span", i)
+			}
+		}
+	})
+
+	t.Run("Telemetry-Client-Middleware-Errors", func(t *testing.T) {
+		recorder, _ := instrumented(t)
+
+		denied := errors.New("denied")
+
+		c := client.New().Use(func(next http.RoundTripper) http.RoundTripper {
+			return transport(func(r *http.Request) (*http.Response, error) {
+				return nil, denied
+			})
+		})
+
+		request, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		if _, e := c.Do(request); !(errors.Is(e, denied)) {
+			t.Fatalf("Expected Middleware Error, Received: %v", e)
+		}
+
+		if span := recorder.Ended()[0]; span.Status().Code != codes.Error {
+			t.Errorf("Middleware Error Not Recorded: %+v", span.Status())
+		}
+	})
+
+	t.Run("Telemetry-Client-Third-Party-Client", func(t *testing.T) {
+		recorder, _ := instrumented(t)
+
+		sdk := &http.Client{Transport: client.Transport(nil)}
+
+		response, e := sdk.Get(server.URL)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Sending Request: %v", e)
+		}
+
+		response.Body.Close()
+
+		if spans := recorder.Ended(); len(spans) != 1 || spans[0].SpanKind() != trace.SpanKindClient {
+			t.Errorf("Round Trip Not Instrumented: %d Spans", len(spans))
+		}
+	})
+
+	t.Run("Telemetry-Client-HTTP-Copy", func(t *testing.T) {
+		c := client.New(func(o *client.Options) {
+			o.Timeout = 5 * time.Second
+		})
+
+		first, second := c.HTTP(), c.HTTP()
+		if first == second {
+			t.Fatal("Expected Independent HTTP Clients")
+		}
+
+		if first.Timeout != 5*time.Second || first.Transport != c {
+			t.Errorf("Unexpected HTTP Client - Timeout: %s, Transport: %T", first.Timeout, first.Transport)
+		}
+
+		first.Timeout = time.Millisecond
+		first.Transport = http.DefaultTransport
+
+		if second.Timeout != 5*time.Second || second.Transport != c || c.HTTP().Timeout != 5*time.Second {
+			t.Error("Modifying a Copy Affected the Client")
+		}
+	})
+}
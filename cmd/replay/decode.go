@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	logscollector "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	metricscollector "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	tracecollector "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+)
+
+// kind identifies the telemetry signal of a [batch]; its value doubles as the OTLP/HTTP url path suffix.
+type kind string
+
+const (
+	traces  kind = "traces"
+	metrics kind = "metrics"
+	logs    kind = "logs"
+)
+
+// batch is a single OTLP export request for one signal.
+type batch struct {
+	signal kind
+
+	// message is one of [tracecollector.ExportTraceServiceRequest], [metricscollector.ExportMetricsServiceRequest] or
+	// [logscollector.ExportLogsServiceRequest], according to signal.
+	message proto.Message
+
+	// items is the number of spans, data-points or log records contained in message.
+	items int
+}
+
+// merge appends the resources of other into b. Both batches must share the same signal.
+func (b *batch) merge(other *batch) {
+	switch message := b.message.(type) {
+	case *tracecollector.ExportTraceServiceRequest:
+		message.ResourceSpans = append(message.ResourceSpans, other.message.(*tracecollector.ExportTraceServiceRequest).ResourceSpans...)
+	case *metricscollector.ExportMetricsServiceRequest:
+		message.ResourceMetrics = append(message.ResourceMetrics, other.message.(*metricscollector.ExportMetricsServiceRequest).ResourceMetrics...)
+	case *logscollector.ExportLogsServiceRequest:
+		message.ResourceLogs = append(message.ResourceLogs, other.message.(*logscollector.ExportLogsServiceRequest).ResourceLogs...)
+	}
+
+	b.items += other.items
+}
+
+// decoder reads a stream of JSON values - either newline-delimited or pretty-printed - and converts each into a [batch].
+type decoder struct {
+	json *json.Decoder
+}
+
+func newDecoder(reader io.Reader) *decoder {
+	d := json.NewDecoder(reader)
+	d.UseNumber()
+
+	return &decoder{json: d}
+}
+
+// next returns the following [batch] in the stream, or [io.EOF] once the stream is exhausted.
+func (d *decoder) next() (*batch, error) {
+	var raw map[string]json.RawMessage
+	if e := d.json.Decode(&raw); e != nil {
+		if errors.Is(e, io.EOF) {
+			return nil, io.EOF
+		}
+
+		return nil, fmt.Errorf("unable to decode json value: %w", e)
+	}
+
+	has := func(key string) bool {
+		_, found := raw[key]
+		return found
+	}
+
+	switch {
+	case has("resourceSpans"), has("resource_spans"):
+		message := new(tracecollector.ExportTraceServiceRequest)
+		if e := unmarshal(raw, message); e != nil {
+			return nil, e
+		}
+
+		var items int
+		for _, resource := range message.ResourceSpans {
+			for _, scope := range resource.ScopeSpans {
+				items += len(scope.Spans)
+			}
+		}
+
+		return &batch{signal: traces, message: message, items: items}, nil
+	case has("resourceMetrics"), has("resource_metrics"):
+		message := new(metricscollector.ExportMetricsServiceRequest)
+		if e := unmarshal(raw, message); e != nil {
+			return nil, e
+		}
+
+		var items int
+		for _, resource := range message.ResourceMetrics {
+			items += points(resource)
+		}
+
+		return &batch{signal: metrics, message: message, items: items}, nil
+	case has("resourceLogs"), has("resource_logs"):
+		message := new(logscollector.ExportLogsServiceRequest)
+		if e := unmarshal(raw, message); e != nil {
+			return nil, e
+		}
+
+		var items int
+		for _, resource := range message.ResourceLogs {
+			for _, scope := range resource.ScopeLogs {
+				items += len(scope.LogRecords)
+			}
+		}
+
+		return &batch{signal: logs, message: message, items: items}, nil
+	case has("SpanContext"):
+		return stdoutSpan(raw)
+	case has("ScopeMetrics"):
+		return stdoutMetrics(raw)
+	case has("Severity"), has("Body"):
+		return stdoutLog(raw)
+	}
+
+	return nil, errors.New("unrecognized json value: expected an otlp export request or a stdout exporter record")
+}
+
+// unmarshal decodes an OTLP JSON export request into message.
+//
+// The OTLP JSON encoding represents trace and span identifiers as hexadecimal strings, whereas [protojson] expects base64
+// for every bytes field; identifiers are therefore converted prior to decoding.
+func unmarshal(raw map[string]json.RawMessage, message proto.Message) error {
+	buffer, e := json.Marshal(raw)
+	if e != nil {
+		return fmt.Errorf("unable to re-encode otlp json: %w", e)
+	}
+
+	// Numbers must be preserved verbatim; nanosecond timestamps exceed float64 precision.
+	var tree map[string]any
+	d := json.NewDecoder(bytes.NewReader(buffer))
+	d.UseNumber()
+	if e := d.Decode(&tree); e != nil {
+		return fmt.Errorf("unable to re-decode otlp json: %w", e)
+	}
+
+	identifiers(tree)
+
+	buffer, e = json.Marshal(tree)
+	if e != nil {
+		return fmt.Errorf("unable to re-encode otlp json: %w", e)
+	}
+
+	if e := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(buffer, message); e != nil {
+		return fmt.Errorf("unable to decode otlp json: %w", e)
+	}
+
+	return nil
+}
+
+// identifiers recursively rewrites hexadecimal trace and span identifiers found in value to base64.
+func identifiers(value any) {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, v := range value {
+			switch key {
+			case "traceId", "spanId", "parentSpanId", "trace_id", "span_id", "parent_span_id":
+				if s, ok := v.(string); ok {
+					if decoded, e := hex.DecodeString(s); e == nil && (len(decoded) == 16 || len(decoded) == 8) {
+						value[key] = base64.StdEncoding.EncodeToString(decoded)
+					}
+				}
+			default:
+				identifiers(v)
+			}
+		}
+	case []any:
+		for _, v := range value {
+			identifiers(v)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// forwarder exports batches to an OTLP/HTTP collector using the protobuf encoding.
+type forwarder struct {
+	endpoint string
+	client   *http.Client
+	headers  http.Header
+	rewrite  *rewriter
+	logger   *slog.Logger
+
+	// rate is the maximum number of items exported per second; zero or less disables rate limiting.
+	rate float64
+
+	started time.Time
+
+	items    [3]atomic.Int64 // indexed by [index]
+	requests atomic.Int64
+	failures atomic.Int64
+}
+
+func index(signal kind) int {
+	switch signal {
+	case traces:
+		return 0
+	case metrics:
+		return 1
+	}
+
+	return 2
+}
+
+// file replays the named input file; "-" denotes stdin.
+func (f *forwarder) file(ctx context.Context, name string, stdin io.Reader, size int) error {
+	reader := stdin
+	if name != "-" {
+		file, e := os.Open(name)
+		if e != nil {
+			return fmt.Errorf("unable to open input file: %w", e)
+		}
+
+		defer file.Close()
+
+		reader = file
+	}
+
+	return f.replay(ctx, newDecoder(reader), size)
+}
+
+// replay exports every batch produced by d, merging consecutive batches of the same signal up to size items.
+func (f *forwarder) replay(ctx context.Context, d *decoder, size int) error {
+	var pending *batch
+
+	flush := func() error {
+		if pending == nil {
+			return nil
+		}
+
+		b := pending
+		pending = nil
+
+		return f.send(ctx, b)
+	}
+
+	for {
+		b, e := d.next()
+		if errors.Is(e, io.EOF) {
+			return flush()
+		} else if e != nil {
+			return e
+		}
+
+		if pending != nil && (pending.signal != b.signal || pending.items+b.items > size) {
+			if e := flush(); e != nil {
+				return e
+			}
+		}
+
+		if pending == nil {
+			pending = b
+		} else {
+			pending.merge(b)
+		}
+	}
+}
+
+// wait blocks until the items exported so far fall within the configured rate.
+func (f *forwarder) wait(ctx context.Context) error {
+	if f.rate <= 0 {
+		return nil
+	}
+
+	var total int64
+	for i := range f.items {
+		total += f.items[i].Load()
+	}
+
+	due := f.started.Add(time.Duration(float64(total) / f.rate * float64(time.Second)))
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(time.Until(due)):
+		return nil
+	}
+}
+
+// send exports a single batch. Export failures are logged and counted rather than returned; only context cancellation
+// and encoding errors abort the replay.
+func (f *forwarder) send(ctx context.Context, b *batch) error {
+	if e := f.wait(ctx); e != nil {
+		return e
+	}
+
+	f.rewrite.apply(b)
+
+	buffer, e := proto.Marshal(b.message)
+	if e != nil {
+		return fmt.Errorf("unable to encode %s export request: %w", b.signal, e)
+	}
+
+	request, e := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/v1/%s", f.endpoint, b.signal), bytes.NewReader(buffer))
+	if e != nil {
+		return fmt.Errorf("unable to create %s export request: %w", b.signal, e)
+	}
+
+	for key, values := range f.headers {
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
+
+	request.Header.Set("Content-Type", "application/x-protobuf")
+
+	f.requests.Add(1)
+	f.items[index(b.signal)].Add(int64(b.items))
+
+	response, e := f.client.Do(request)
+	if e != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		f.failures.Add(1)
+		f.logger.ErrorContext(ctx, "Export Request Failure", slog.String("signal", string(b.signal)), slog.String("error", e.Error()))
+
+		return nil
+	}
+
+	defer response.Body.Close()
+
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		f.failures.Add(1)
+		f.logger.ErrorContext(ctx, "Export Request Rejected", slog.String("signal", string(b.signal)), slog.Int("status", response.StatusCode))
+	}
+
+	return nil
+}
+
+// report starts periodic progress reporting and returns a function that stops it and logs a final summary. The returned
+// function is safe to call more than once.
+func (f *forwarder) report(ctx context.Context, interval time.Duration) (stop func()) {
+	f.started = time.Now()
+
+	done := make(chan struct{})
+
+	log := func(message string) {
+		elapsed := time.Since(f.started)
+
+		var total int64
+		for i := range f.items {
+			total += f.items[i].Load()
+		}
+
+		f.logger.InfoContext(ctx, message,
+			slog.Int64("spans", f.items[index(traces)].Load()),
+			slog.Int64("data-points", f.items[index(metrics)].Load()),
+			slog.Int64("log-records", f.items[index(logs)].Load()),
+			slog.Int64("requests", f.requests.Load()),
+			slog.Int64("failures", f.failures.Load()),
+			slog.String("elapsed", elapsed.Round(time.Millisecond).String()),
+			slog.Float64("items-per-second", float64(total)/max(elapsed.Seconds(), 1e-9)),
+		)
+	}
+
+	if interval > 0 {
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-done:
+					return
+				case <-ticker.C:
+					log("Replay Progress")
+				}
+			}
+		}()
+	}
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			close(done)
+
+			log("Replay Complete")
+		})
+	}
+}
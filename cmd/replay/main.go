@@ -0,0 +1,147 @@
+// Command replay reads telemetry previously written to files and forwards it to an OTLP/HTTP collector.
+//
+// Supported inputs are OTLP JSON (one export request per line, e.g. the collector's file exporter), and the pretty-printed
+// JSON emitted by the stdout exporters configured through [telemetry.Tracer.Writer], [telemetry.Metrics.Writer] and
+// [telemetry.Logs.Writer]. Both formats may be mixed within, and across, input files.
+//
+// Usage:
+//
+//	replay [flags] [file ...]
+//
+// When no files are given, or a file is "-", standard-input is read.
+//
+// Example:
+//
+//	replay -endpoint http://localhost:4318 -timestamps shift -resource deployment.environment=replay -rate 500 traces.json logs.json
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// pairs is a repeatable "key=value" command-line flag.
+type pairs [][2]string
+
+func (p *pairs) String() string {
+	values := make([]string, 0, len(*p))
+	for _, pair := range *p {
+		values = append(values, pair[0]+"="+pair[1])
+	}
+
+	return strings.Join(values, ",")
+}
+
+func (p *pairs) Set(value string) error {
+	key, v, found := strings.Cut(value, "=")
+	if !(found) || key == "" {
+		return fmt.Errorf("invalid key=value pair: %q", value)
+	}
+
+	*p = append(*p, [2]string{key, v})
+
+	return nil
+}
+
+// run parses the command-line arguments and replays every input to the configured collector.
+func run(ctx context.Context, arguments []string, stdin io.Reader, stderr io.Writer) error {
+	flags := flag.NewFlagSet("replay", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	var (
+		endpoint   = flags.String("endpoint", "http://localhost:4318", "base url of the OTLP/HTTP collector; signals are sent to {endpoint}/v1/{traces,metrics,logs}")
+		timestamps = flags.String("timestamps", "keep", "timestamp rewrite mode: \"keep\" leaves timestamps untouched, \"shift\" moves them so the first one lands at replay time")
+		rate       = flags.Float64("rate", 0, "maximum number of spans, data-points and log records forwarded per second; 0 disables rate limiting")
+		size       = flags.Int("batch", 512, "maximum number of items merged into a single export request")
+		progress   = flags.Duration("progress", 5*time.Second, "interval between progress reports; 0 disables reporting")
+		timeout    = flags.Duration("timeout", 15*time.Second, "timeout of each export request")
+		resource   pairs
+		headers    pairs
+	)
+
+	flags.Var(&resource, "resource", "key=value resource attribute set on every replayed resource (repeatable)")
+	flags.Var(&headers, "header", "key=value header added to every export request (repeatable)")
+
+	if e := flags.Parse(arguments); e != nil {
+		return e
+	}
+
+	var rewrite *rewriter
+	switch *timestamps {
+	case "keep":
+		rewrite = &rewriter{resource: resource}
+	case "shift":
+		rewrite = &rewriter{resource: resource, shift: true, now: time.Now}
+	default:
+		return fmt.Errorf("invalid timestamps mode: %q", *timestamps)
+	}
+
+	if *size <= 0 {
+		return fmt.Errorf("invalid batch size: %d", *size)
+	}
+
+	header := make(http.Header)
+	for _, pair := range headers {
+		header.Add(pair[0], pair[1])
+	}
+
+	logger := slog.New(slog.NewTextHandler(stderr, nil))
+
+	f := &forwarder{
+		endpoint: strings.TrimSuffix(*endpoint, "/"),
+		client:   &http.Client{Timeout: *timeout},
+		headers:  header,
+		rate:     *rate,
+		rewrite:  rewrite,
+		logger:   logger,
+	}
+
+	files := flags.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+
+	stop := f.report(ctx, *progress)
+	defer stop()
+
+	for _, name := range files {
+		if e := f.file(ctx, name, stdin, *size); e != nil {
+			return fmt.Errorf("unable to replay %q: %w", name, e)
+		}
+	}
+
+	stop()
+
+	if failures := f.failures.Load(); failures > 0 {
+		return fmt.Errorf("%d export request(s) failed", failures)
+	}
+
+	return nil
+}
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	if e := run(ctx, os.Args[1:], os.Stdin, os.Stderr); e != nil {
+		if errors.Is(e, flag.ErrHelp) {
+			return
+		}
+
+		slog.ErrorContext(ctx, "Telemetry Replay Failure", slog.String("error", e.Error()))
+
+		cancel()
+
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/contrib/bridges/otelslog"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/proto"
+
+	logscollector "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	metricscollector "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	tracecollector "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+// collector is an in-process, fake OTLP/HTTP collector recording every export request it receives.
+type collector struct {
+	mutex sync.Mutex
+
+	traces  []*tracecollector.ExportTraceServiceRequest
+	metrics []*metricscollector.ExportMetricsServiceRequest
+	logs    []*logscollector.ExportLogsServiceRequest
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	buffer, e := io.ReadAll(r.Body)
+	if e != nil || r.Header.Get("Content-Type") != "application/x-protobuf" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var message proto.Message
+	switch r.URL.Path {
+	case "/v1/traces":
+		request := new(tracecollector.ExportTraceServiceRequest)
+		c.traces = append(c.traces, request)
+		message = request
+	case "/v1/metrics":
+		request := new(metricscollector.ExportMetricsServiceRequest)
+		c.metrics = append(c.metrics, request)
+		message = request
+	case "/v1/logs":
+		request := new(logscollector.ExportLogsServiceRequest)
+		c.logs = append(c.logs, request)
+		message = request
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if e := proto.Unmarshal(buffer, message); e != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func lookup(resource *resourcepb.Resource, key string) string {
+	for _, kv := range resource.GetAttributes() {
+		if kv.Key == key {
+			return kv.Value.GetStringValue()
+		}
+	}
+
+	return ""
+}
+
+func Test(t *testing.T) {
+	t.Run("Replay-Stdout-Exporters", func(t *testing.T) {
+		ctx := context.Background()
+
+		var traces, metrics, logs bytes.Buffer
+
+		shutdown := telemetry.Setup(ctx, func(options *telemetry.Settings) {
+			options.Zipkin.Enabled = false // disabled during testing
+
+			options.Tracer = &telemetry.Tracer{
+				Local:  true,
+				Writer: &traces,
+			}
+
+			options.Metrics = &telemetry.Metrics{
+				Local:  true,
+				Writer: &metrics,
+			}
+
+			options.Logs = &telemetry.Logs{
+				Local:  true,
+				Writer: &logs,
+			}
+		})
+
+		sctx, span := otel.Tracer("replay-test").Start(ctx, "replay-test-span")
+
+		counter, e := otel.Meter("replay-test").Int64Counter("replay-test-counter")
+		if e != nil {
+			t.Fatalf("Unexpected Error While Creating Counter: %v", e)
+		}
+
+		counter.Add(sctx, 3)
+
+		otelslog.NewLogger("replay-test").InfoContext(sctx, "Replay Test Log Message")
+
+		span.End()
+
+		if e := shutdown(ctx); e != nil {
+			t.Fatalf("Unexpected Error During Shutdown: %v", e)
+		}
+
+		directory := t.TempDir()
+		files := make([]string, 0, 3)
+		for name, buffer := range map[string]*bytes.Buffer{"traces.json": &traces, "metrics.json": &metrics, "logs.json": &logs} {
+			path := filepath.Join(directory, name)
+			if e := os.WriteFile(path, buffer.Bytes(), 0o600); e != nil {
+				t.Fatalf("Unexpected Error While Writing Input File: %v", e)
+			}
+
+			files = append(files, path)
+		}
+
+		c := new(collector)
+		server := httptest.NewServer(c)
+		defer server.Close()
+
+		before := uint64(time.Now().UnixNano())
+
+		var stderr bytes.Buffer
+		arguments := append([]string{"-endpoint", server.URL, "-timestamps", "shift", "-resource", "deployment.environment=replay", "-progress", "0"}, files...)
+		if e := run(ctx, arguments, strings.NewReader(""), &stderr); e != nil {
+			t.Fatalf("Unexpected Replay Error: %v\n%s", e, stderr.String())
+		}
+
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		t.Run("Traces", func(t *testing.T) {
+			if len(c.traces) != 1 {
+				t.Fatalf("Expected 1 Trace Export Request, Received %d", len(c.traces))
+			}
+
+			rs := c.traces[0].ResourceSpans[0]
+			if v := lookup(rs.Resource, "deployment.environment"); v != "replay" {
+				t.Errorf("Unexpected Resource Attribute Value: %q", v)
+			}
+
+			s := rs.ScopeSpans[0].Spans[0]
+			if s.Name != "replay-test-span" {
+				t.Errorf("Unexpected Span Name: %q", s.Name)
+			}
+
+			if len(s.TraceId) != 16 || len(s.SpanId) != 8 {
+				t.Errorf("Invalid Span Identifiers: %x, %x", s.TraceId, s.SpanId)
+			}
+
+			// The local tracer omits timestamps; shifting must fill them with the time of replay.
+			if s.StartTimeUnixNano < before || s.EndTimeUnixNano < before {
+				t.Errorf("Timestamps Not Rewritten: %d, %d", s.StartTimeUnixNano, s.EndTimeUnixNano)
+			}
+		})
+
+		t.Run("Metrics", func(t *testing.T) {
+			if len(c.metrics) == 0 {
+				t.Fatal("No Metrics Export Requests Received")
+			}
+
+			var found bool
+			for _, request := range c.metrics {
+				for _, rm := range request.ResourceMetrics {
+					for _, sm := range rm.ScopeMetrics {
+						for _, m := range sm.Metrics {
+							if m.Name == "replay-test-counter" {
+								found = true
+								if v := m.GetSum().GetDataPoints()[0].GetAsInt(); v != 3 {
+									t.Errorf("Unexpected Counter Value: %d", v)
+								}
+							}
+						}
+					}
+				}
+			}
+
+			if !(found) {
+				t.Error("Counter Not Replayed")
+			}
+		})
+
+		t.Run("Logs", func(t *testing.T) {
+			if len(c.logs) != 1 {
+				t.Fatalf("Expected 1 Log Export Request, Received %d", len(c.logs))
+			}
+
+			record := c.logs[0].ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+			if v := record.Body.GetStringValue(); v != "Replay Test Log Message" {
+				t.Errorf("Unexpected Log Body: %q", v)
+			}
+
+			if len(record.TraceId) != 16 {
+				t.Errorf("Log Record Missing Trace Correlation: %x", record.TraceId)
+			}
+		})
+	})
+
+	t.Run("Replay-OTLP-JSON-Lines", func(t *testing.T) {
+		ctx := context.Background()
+
+		const line = `{"resourceSpans":[{"resource":{"attributes":[{"key":"service.name","value":{"stringValue":"%s"}}]},"scopeSpans":[{"scope":{"name":"replay"},"spans":[{"traceId":"5b8efff798038103d269b633813fc60c","spanId":"eee19b7ec3c1b174","name":"%s","kind":2,"startTimeUnixNano":"1544712660000000000","endTimeUnixNano":"1544712661000000000"}]}]}]}`
+
+		input := fmt.Sprintf(line, "a", "first") + "\n" + fmt.Sprintf(line, "b", "second") + "\n"
+
+		c := new(collector)
+		server := httptest.NewServer(c)
+		defer server.Close()
+
+		var stderr bytes.Buffer
+		if e := run(ctx, []string{"-endpoint", server.URL, "-rate", "1000", "-progress", "0", "-"}, strings.NewReader(input), &stderr); e != nil {
+			t.Fatalf("Unexpected Replay Error: %v\n%s", e, stderr.String())
+		}
+
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		if len(c.traces) != 1 {
+			t.Fatalf("Expected Consecutive Requests to be Merged Into 1 Export Request, Received %d", len(c.traces))
+		}
+
+		if n := len(c.traces[0].ResourceSpans); n != 2 {
+			t.Fatalf("Expected 2 Resource Spans, Received %d", n)
+		}
+
+		s := c.traces[0].ResourceSpans[1].ScopeSpans[0].Spans[0]
+		if s.Name != "second" || fmt.Sprintf("%x", s.TraceId) != "5b8efff798038103d269b633813fc60c" {
+			t.Errorf("Unexpected Span: %q, %x", s.Name, s.TraceId)
+		}
+
+		// Timestamps must remain untouched by default.
+		if s.StartTimeUnixNano != 1544712660000000000 || s.EndTimeUnixNano != 1544712661000000000 {
+			t.Errorf("Unexpected Timestamps: %d, %d", s.StartTimeUnixNano, s.EndTimeUnixNano)
+		}
+	})
+}
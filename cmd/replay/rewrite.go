@@ -0,0 +1,155 @@
+package main
+
+import (
+	"time"
+
+	logscollector "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	metricscollector "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	tracecollector "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+// rewriter mutates batches prior to export.
+type rewriter struct {
+	// resource attributes are set on, or overwrite the existing attributes of, every resource.
+	resource pairs
+
+	// shift moves every timestamp by a constant offset such that the first non-zero timestamp encountered lands at the
+	// time of replay, preserving the relative spacing of the original data. Zero (absent) timestamps, as written by
+	// exporters configured without timestamps, are replaced with the time of replay.
+	shift bool
+
+	// now returns the current time; only used when shift is enabled.
+	now func() time.Time
+
+	offset   int64
+	anchored bool
+}
+
+// apply rewrites b in place.
+func (r *rewriter) apply(b *batch) {
+	switch message := b.message.(type) {
+	case *tracecollector.ExportTraceServiceRequest:
+		for _, rs := range message.ResourceSpans {
+			rs.Resource = r.attributes(rs.Resource)
+			for _, ss := range rs.ScopeSpans {
+				for _, span := range ss.Spans {
+					r.timestamp(&span.StartTimeUnixNano)
+					r.timestamp(&span.EndTimeUnixNano)
+					for _, event := range span.Events {
+						r.timestamp(&event.TimeUnixNano)
+					}
+				}
+			}
+		}
+	case *metricscollector.ExportMetricsServiceRequest:
+		for _, rm := range message.ResourceMetrics {
+			rm.Resource = r.attributes(rm.Resource)
+			for _, sm := range rm.ScopeMetrics {
+				for _, m := range sm.Metrics {
+					r.metric(m)
+				}
+			}
+		}
+	case *logscollector.ExportLogsServiceRequest:
+		for _, rl := range message.ResourceLogs {
+			rl.Resource = r.attributes(rl.Resource)
+			for _, sl := range rl.ScopeLogs {
+				for _, record := range sl.LogRecords {
+					r.timestamp(&record.TimeUnixNano)
+					r.timestamp(&record.ObservedTimeUnixNano)
+				}
+			}
+		}
+	}
+}
+
+func (r *rewriter) metric(m *metricspb.Metric) {
+	exemplars := func(list []*metricspb.Exemplar) {
+		for _, exemplar := range list {
+			r.timestamp(&exemplar.TimeUnixNano)
+		}
+	}
+
+	switch data := m.Data.(type) {
+	case *metricspb.Metric_Gauge:
+		for _, dp := range data.Gauge.DataPoints {
+			r.timestamp(&dp.StartTimeUnixNano)
+			r.timestamp(&dp.TimeUnixNano)
+			exemplars(dp.Exemplars)
+		}
+	case *metricspb.Metric_Sum:
+		for _, dp := range data.Sum.DataPoints {
+			r.timestamp(&dp.StartTimeUnixNano)
+			r.timestamp(&dp.TimeUnixNano)
+			exemplars(dp.Exemplars)
+		}
+	case *metricspb.Metric_Histogram:
+		for _, dp := range data.Histogram.DataPoints {
+			r.timestamp(&dp.StartTimeUnixNano)
+			r.timestamp(&dp.TimeUnixNano)
+			exemplars(dp.Exemplars)
+		}
+	case *metricspb.Metric_ExponentialHistogram:
+		for _, dp := range data.ExponentialHistogram.DataPoints {
+			r.timestamp(&dp.StartTimeUnixNano)
+			r.timestamp(&dp.TimeUnixNano)
+			exemplars(dp.Exemplars)
+		}
+	case *metricspb.Metric_Summary:
+		for _, dp := range data.Summary.DataPoints {
+			r.timestamp(&dp.StartTimeUnixNano)
+			r.timestamp(&dp.TimeUnixNano)
+		}
+	}
+}
+
+func (r *rewriter) timestamp(v *uint64) {
+	if !(r.shift) {
+		return
+	}
+
+	now := r.now().UnixNano()
+
+	if *v == 0 {
+		*v = uint64(now)
+		return
+	}
+
+	if !(r.anchored) {
+		r.offset = now - int64(*v)
+		r.anchored = true
+	}
+
+	*v = uint64(int64(*v) + r.offset)
+}
+
+func (r *rewriter) attributes(resource *resourcepb.Resource) *resourcepb.Resource {
+	if len(r.resource) == 0 {
+		return resource
+	}
+
+	if resource == nil {
+		resource = new(resourcepb.Resource)
+	}
+
+	for _, pair := range r.resource {
+		value := &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: pair[1]}}
+
+		replaced := false
+		for _, kv := range resource.Attributes {
+			if kv.Key == pair[0] {
+				kv.Value = value
+				replaced = true
+			}
+		}
+
+		if !(replaced) {
+			resource.Attributes = append(resource.Attributes, &commonpb.KeyValue{Key: pair[0], Value: value})
+		}
+	}
+
+	return resource
+}
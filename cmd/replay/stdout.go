@@ -0,0 +1,722 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	logscollector "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	metricscollector "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	tracecollector "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+// The types below mirror the JSON encodings of the stdouttrace, stdoutmetric and stdoutlog exporters.
+
+type stdoutValue struct {
+	Type  string
+	Value json.RawMessage
+}
+
+type stdoutAttribute struct {
+	Key   string
+	Value stdoutValue
+}
+
+type stdoutScope struct {
+	Name      string
+	Version   string
+	SchemaURL string
+}
+
+type stdoutSpanContext struct {
+	TraceID    string
+	SpanID     string
+	TraceFlags string
+	TraceState string
+}
+
+type stdoutExemplar struct {
+	FilteredAttributes []stdoutAttribute
+	Time               time.Time
+	Value              json.Number
+	SpanID             []byte
+	TraceID            []byte
+}
+
+type stdoutBucket struct {
+	Offset int32
+	Counts []uint64
+}
+
+type stdoutDataPoint struct {
+	Attributes []stdoutAttribute
+	StartTime  time.Time
+	Time       time.Time
+	Exemplars  []stdoutExemplar
+
+	// Value is only present for gauges and sums.
+	Value json.Number
+
+	// Count and the fields following it are only present for histograms.
+	Count          *uint64
+	Sum            json.Number
+	Min            *float64
+	Max            *float64
+	Bounds         []float64
+	BucketCounts   []uint64
+	Scale          *int32
+	ZeroCount      uint64
+	ZeroThreshold  float64
+	PositiveBucket stdoutBucket
+	NegativeBucket stdoutBucket
+}
+
+// nanoseconds converts t into an OTLP timestamp; the zero [time.Time] (as emitted when timestamps are disabled) maps to 0.
+func nanoseconds(t time.Time) uint64 {
+	if t.IsZero() || t.Unix() <= 0 {
+		return 0
+	}
+
+	return uint64(t.UnixNano())
+}
+
+// integral reports whether the number was encoded without a fractional part or exponent.
+func integral(n json.Number) bool {
+	return !(strings.ContainsAny(n.String(), ".eE"))
+}
+
+func identifier(value string) []byte {
+	decoded, e := hex.DecodeString(value)
+	if e != nil {
+		return nil
+	}
+
+	for _, b := range decoded {
+		if b != 0 {
+			return decoded
+		}
+	}
+
+	return nil // all-zero identifiers are invalid, i.e. absent.
+}
+
+func flags(value string) uint32 {
+	parsed, e := strconv.ParseUint(value, 16, 8)
+	if e != nil {
+		return 0
+	}
+
+	return uint32(parsed)
+}
+
+// attribute converts an attribute as encoded by [attribute.KeyValue]'s JSON representation.
+func attribute(a stdoutAttribute) (*commonpb.KeyValue, error) {
+	var result commonpb.AnyValue
+
+	decode := func(target any) error {
+		if e := json.Unmarshal(a.Value.Value, target); e != nil {
+			return fmt.Errorf("unable to decode %s attribute %q: %w", a.Value.Type, a.Key, e)
+		}
+
+		return nil
+	}
+
+	switch a.Value.Type {
+	case "STRING":
+		var v string
+		if e := decode(&v); e != nil {
+			return nil, e
+		}
+
+		result.Value = &commonpb.AnyValue_StringValue{StringValue: v}
+	case "BOOL":
+		var v bool
+		if e := decode(&v); e != nil {
+			return nil, e
+		}
+
+		result.Value = &commonpb.AnyValue_BoolValue{BoolValue: v}
+	case "INT64":
+		var v int64
+		if e := decode(&v); e != nil {
+			return nil, e
+		}
+
+		result.Value = &commonpb.AnyValue_IntValue{IntValue: v}
+	case "FLOAT64":
+		var v float64
+		if e := decode(&v); e != nil {
+			return nil, e
+		}
+
+		result.Value = &commonpb.AnyValue_DoubleValue{DoubleValue: v}
+	case "STRINGSLICE", "BOOLSLICE", "INT64SLICE", "FLOAT64SLICE":
+		var v []json.RawMessage
+		if e := decode(&v); e != nil {
+			return nil, e
+		}
+
+		element := strings.TrimSuffix(a.Value.Type, "SLICE")
+		values := make([]*commonpb.AnyValue, 0, len(v))
+		for _, raw := range v {
+			kv, e := attribute(stdoutAttribute{Key: a.Key, Value: stdoutValue{Type: element, Value: raw}})
+			if e != nil {
+				return nil, e
+			}
+
+			values = append(values, kv.Value)
+		}
+
+		result.Value = &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{Values: values}}
+	default:
+		return nil, fmt.Errorf("unsupported attribute type %q for attribute %q", a.Value.Type, a.Key)
+	}
+
+	return &commonpb.KeyValue{Key: a.Key, Value: &result}, nil
+}
+
+func attributes(list []stdoutAttribute) ([]*commonpb.KeyValue, error) {
+	converted := make([]*commonpb.KeyValue, 0, len(list))
+	for _, a := range list {
+		kv, e := attribute(a)
+		if e != nil {
+			return nil, e
+		}
+
+		converted = append(converted, kv)
+	}
+
+	return converted, nil
+}
+
+// value converts a log value as encoded by the stdoutlog exporter.
+func value(v stdoutValue) (*commonpb.AnyValue, error) {
+	decode := func(target any) error {
+		if e := json.Unmarshal(v.Value, target); e != nil {
+			return fmt.Errorf("unable to decode %s log value: %w", v.Type, e)
+		}
+
+		return nil
+	}
+
+	switch v.Type {
+	case "String":
+		var s string
+		if e := decode(&s); e != nil {
+			return nil, e
+		}
+
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: s}}, nil
+	case "Int64":
+		var i int64
+		if e := decode(&i); e != nil {
+			return nil, e
+		}
+
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: i}}, nil
+	case "Float64":
+		var f float64
+		if e := decode(&f); e != nil {
+			return nil, e
+		}
+
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: f}}, nil
+	case "Bool":
+		var b bool
+		if e := decode(&b); e != nil {
+			return nil, e
+		}
+
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: b}}, nil
+	case "Bytes":
+		var b []byte
+		if e := decode(&b); e != nil {
+			return nil, e
+		}
+
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BytesValue{BytesValue: b}}, nil
+	case "Map":
+		var m []struct {
+			Key   string
+			Value stdoutValue
+		}
+
+		if e := decode(&m); e != nil {
+			return nil, e
+		}
+
+		values := make([]*commonpb.KeyValue, 0, len(m))
+		for _, kv := range m {
+			converted, e := value(kv.Value)
+			if e != nil {
+				return nil, e
+			}
+
+			values = append(values, &commonpb.KeyValue{Key: kv.Key, Value: converted})
+		}
+
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_KvlistValue{KvlistValue: &commonpb.KeyValueList{Values: values}}}, nil
+	case "Slice":
+		var s []stdoutValue
+		if e := decode(&s); e != nil {
+			return nil, e
+		}
+
+		values := make([]*commonpb.AnyValue, 0, len(s))
+		for _, element := range s {
+			converted, e := value(element)
+			if e != nil {
+				return nil, e
+			}
+
+			values = append(values, converted)
+		}
+
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{Values: values}}}, nil
+	case "Empty", "":
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("unsupported log value type %q", v.Type)
+}
+
+func scope(s stdoutScope) *commonpb.InstrumentationScope {
+	return &commonpb.InstrumentationScope{Name: s.Name, Version: s.Version}
+}
+
+func resource(list []stdoutAttribute) (*resourcepb.Resource, error) {
+	converted, e := attributes(list)
+	if e != nil {
+		return nil, fmt.Errorf("unable to convert resource: %w", e)
+	}
+
+	return &resourcepb.Resource{Attributes: converted}, nil
+}
+
+// stdoutSpan converts a single span emitted by the stdouttrace exporter.
+func stdoutSpan(raw map[string]json.RawMessage) (*batch, error) {
+	var span struct {
+		Name        string
+		SpanContext stdoutSpanContext
+		Parent      stdoutSpanContext
+		SpanKind    int32
+		StartTime   time.Time
+		EndTime     time.Time
+		Attributes  []stdoutAttribute
+		Events      []struct {
+			Name                  string
+			Attributes            []stdoutAttribute
+			DroppedAttributeCount uint32
+			Time                  time.Time
+		}
+		Links []struct {
+			SpanContext           stdoutSpanContext
+			Attributes            []stdoutAttribute
+			DroppedAttributeCount uint32
+		}
+		Status struct {
+			Code        string
+			Description string
+		}
+		DroppedAttributes    uint32
+		DroppedEvents        uint32
+		DroppedLinks         uint32
+		Resource             []stdoutAttribute
+		InstrumentationScope stdoutScope
+	}
+
+	if e := remarshal(raw, &span); e != nil {
+		return nil, fmt.Errorf("unable to decode stdout span: %w", e)
+	}
+
+	converted, e := attributes(span.Attributes)
+	if e != nil {
+		return nil, e
+	}
+
+	s := &tracepb.Span{
+		TraceId:                identifier(span.SpanContext.TraceID),
+		SpanId:                 identifier(span.SpanContext.SpanID),
+		TraceState:             span.SpanContext.TraceState,
+		ParentSpanId:           identifier(span.Parent.SpanID),
+		Flags:                  flags(span.SpanContext.TraceFlags),
+		Name:                   span.Name,
+		Kind:                   tracepb.Span_SpanKind(span.SpanKind),
+		StartTimeUnixNano:      nanoseconds(span.StartTime),
+		EndTimeUnixNano:        nanoseconds(span.EndTime),
+		Attributes:             converted,
+		DroppedAttributesCount: span.DroppedAttributes,
+		DroppedEventsCount:     span.DroppedEvents,
+		DroppedLinksCount:      span.DroppedLinks,
+		Status:                 &tracepb.Status{Message: span.Status.Description},
+	}
+
+	switch span.Status.Code {
+	case "Ok":
+		s.Status.Code = tracepb.Status_STATUS_CODE_OK
+	case "Error":
+		s.Status.Code = tracepb.Status_STATUS_CODE_ERROR
+	}
+
+	for _, event := range span.Events {
+		converted, e := attributes(event.Attributes)
+		if e != nil {
+			return nil, e
+		}
+
+		s.Events = append(s.Events, &tracepb.Span_Event{
+			TimeUnixNano:           nanoseconds(event.Time),
+			Name:                   event.Name,
+			Attributes:             converted,
+			DroppedAttributesCount: event.DroppedAttributeCount,
+		})
+	}
+
+	for _, link := range span.Links {
+		converted, e := attributes(link.Attributes)
+		if e != nil {
+			return nil, e
+		}
+
+		s.Links = append(s.Links, &tracepb.Span_Link{
+			TraceId:                identifier(link.SpanContext.TraceID),
+			SpanId:                 identifier(link.SpanContext.SpanID),
+			TraceState:             link.SpanContext.TraceState,
+			Attributes:             converted,
+			DroppedAttributesCount: link.DroppedAttributeCount,
+			Flags:                  flags(link.SpanContext.TraceFlags),
+		})
+	}
+
+	r, e := resource(span.Resource)
+	if e != nil {
+		return nil, e
+	}
+
+	message := &tracecollector.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{
+			{
+				Resource: r,
+				ScopeSpans: []*tracepb.ScopeSpans{
+					{Scope: scope(span.InstrumentationScope), SchemaUrl: span.InstrumentationScope.SchemaURL, Spans: []*tracepb.Span{s}},
+				},
+			},
+		},
+	}
+
+	return &batch{signal: traces, message: message, items: 1}, nil
+}
+
+// stdoutMetrics converts a single resource-metrics collection emitted by the stdoutmetric exporter.
+//
+// The exporter's encoding drops the aggregation type, which is therefore inferred from the data-points' fields.
+func stdoutMetrics(raw map[string]json.RawMessage) (*batch, error) {
+	var collection struct {
+		Resource     []stdoutAttribute
+		ScopeMetrics []struct {
+			Scope   stdoutScope
+			Metrics []struct {
+				Name        string
+				Description string
+				Unit        string
+				Data        struct {
+					DataPoints  []stdoutDataPoint
+					Temporality string
+					IsMonotonic *bool
+				}
+			}
+		}
+	}
+
+	if e := remarshal(raw, &collection); e != nil {
+		return nil, fmt.Errorf("unable to decode stdout metrics: %w", e)
+	}
+
+	r, e := resource(collection.Resource)
+	if e != nil {
+		return nil, e
+	}
+
+	rm := &metricspb.ResourceMetrics{Resource: r}
+
+	for _, sm := range collection.ScopeMetrics {
+		converted := &metricspb.ScopeMetrics{Scope: scope(sm.Scope), SchemaUrl: sm.Scope.SchemaURL}
+
+		for _, m := range sm.Metrics {
+			if len(m.Data.DataPoints) == 0 {
+				continue
+			}
+
+			temporality := metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE
+			if m.Data.Temporality == "DeltaTemporality" {
+				temporality = metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA
+			}
+
+			metric := &metricspb.Metric{Name: m.Name, Description: m.Description, Unit: m.Unit}
+
+			first := m.Data.DataPoints[0]
+
+			switch {
+			case first.Scale != nil:
+				aggregation := &metricspb.ExponentialHistogram{AggregationTemporality: temporality}
+				for _, dp := range m.Data.DataPoints {
+					base, exemplars, e := common(dp)
+					if e != nil {
+						return nil, e
+					}
+
+					aggregation.DataPoints = append(aggregation.DataPoints, &metricspb.ExponentialHistogramDataPoint{
+						Attributes:        base,
+						StartTimeUnixNano: nanoseconds(dp.StartTime),
+						TimeUnixNano:      nanoseconds(dp.Time),
+						Count:             count(dp.Count),
+						Sum:               sum(dp.Sum),
+						Scale:             *dp.Scale,
+						ZeroCount:         dp.ZeroCount,
+						ZeroThreshold:     dp.ZeroThreshold,
+						Positive:          &metricspb.ExponentialHistogramDataPoint_Buckets{Offset: dp.PositiveBucket.Offset, BucketCounts: dp.PositiveBucket.Counts},
+						Negative:          &metricspb.ExponentialHistogramDataPoint_Buckets{Offset: dp.NegativeBucket.Offset, BucketCounts: dp.NegativeBucket.Counts},
+						Min:               dp.Min,
+						Max:               dp.Max,
+						Exemplars:         exemplars,
+					})
+				}
+
+				metric.Data = &metricspb.Metric_ExponentialHistogram{ExponentialHistogram: aggregation}
+			case first.Count != nil:
+				aggregation := &metricspb.Histogram{AggregationTemporality: temporality}
+				for _, dp := range m.Data.DataPoints {
+					base, exemplars, e := common(dp)
+					if e != nil {
+						return nil, e
+					}
+
+					aggregation.DataPoints = append(aggregation.DataPoints, &metricspb.HistogramDataPoint{
+						Attributes:        base,
+						StartTimeUnixNano: nanoseconds(dp.StartTime),
+						TimeUnixNano:      nanoseconds(dp.Time),
+						Count:             count(dp.Count),
+						Sum:               sum(dp.Sum),
+						BucketCounts:      dp.BucketCounts,
+						ExplicitBounds:    dp.Bounds,
+						Min:               dp.Min,
+						Max:               dp.Max,
+						Exemplars:         exemplars,
+					})
+				}
+
+				metric.Data = &metricspb.Metric_Histogram{Histogram: aggregation}
+			default:
+				var numbers []*metricspb.NumberDataPoint
+				for _, dp := range m.Data.DataPoints {
+					base, exemplars, e := common(dp)
+					if e != nil {
+						return nil, e
+					}
+
+					point := &metricspb.NumberDataPoint{
+						Attributes:        base,
+						StartTimeUnixNano: nanoseconds(dp.StartTime),
+						TimeUnixNano:      nanoseconds(dp.Time),
+						Exemplars:         exemplars,
+					}
+
+					if integral(dp.Value) {
+						v, _ := dp.Value.Int64()
+						point.Value = &metricspb.NumberDataPoint_AsInt{AsInt: v}
+					} else {
+						v, _ := dp.Value.Float64()
+						point.Value = &metricspb.NumberDataPoint_AsDouble{AsDouble: v}
+					}
+
+					numbers = append(numbers, point)
+				}
+
+				if m.Data.IsMonotonic != nil {
+					metric.Data = &metricspb.Metric_Sum{Sum: &metricspb.Sum{DataPoints: numbers, AggregationTemporality: temporality, IsMonotonic: *m.Data.IsMonotonic}}
+				} else {
+					metric.Data = &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{DataPoints: numbers}}
+				}
+			}
+
+			converted.Metrics = append(converted.Metrics, metric)
+		}
+
+		rm.ScopeMetrics = append(rm.ScopeMetrics, converted)
+	}
+
+	message := &metricscollector.ExportMetricsServiceRequest{ResourceMetrics: []*metricspb.ResourceMetrics{rm}}
+
+	return &batch{signal: metrics, message: message, items: points(rm)}, nil
+}
+
+// common converts the attributes and exemplars shared by every data-point type.
+func common(dp stdoutDataPoint) ([]*commonpb.KeyValue, []*metricspb.Exemplar, error) {
+	converted, e := attributes(dp.Attributes)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	exemplars := make([]*metricspb.Exemplar, 0, len(dp.Exemplars))
+	for _, exemplar := range dp.Exemplars {
+		filtered, e := attributes(exemplar.FilteredAttributes)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		x := &metricspb.Exemplar{
+			FilteredAttributes: filtered,
+			TimeUnixNano:       nanoseconds(exemplar.Time),
+			SpanId:             exemplar.SpanID,
+			TraceId:            exemplar.TraceID,
+		}
+
+		if integral(exemplar.Value) {
+			v, _ := exemplar.Value.Int64()
+			x.Value = &metricspb.Exemplar_AsInt{AsInt: v}
+		} else {
+			v, _ := exemplar.Value.Float64()
+			x.Value = &metricspb.Exemplar_AsDouble{AsDouble: v}
+		}
+
+		exemplars = append(exemplars, x)
+	}
+
+	return converted, exemplars, nil
+}
+
+func count(v *uint64) uint64 {
+	if v == nil {
+		return 0
+	}
+
+	return *v
+}
+
+func sum(n json.Number) *float64 {
+	if n == "" {
+		return nil
+	}
+
+	v, e := n.Float64()
+	if e != nil {
+		return nil
+	}
+
+	return &v
+}
+
+// points counts the data-points contained in a resource-metrics collection.
+func points(rm *metricspb.ResourceMetrics) (n int) {
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case *metricspb.Metric_Gauge:
+				n += len(data.Gauge.DataPoints)
+			case *metricspb.Metric_Sum:
+				n += len(data.Sum.DataPoints)
+			case *metricspb.Metric_Histogram:
+				n += len(data.Histogram.DataPoints)
+			case *metricspb.Metric_ExponentialHistogram:
+				n += len(data.ExponentialHistogram.DataPoints)
+			case *metricspb.Metric_Summary:
+				n += len(data.Summary.DataPoints)
+			}
+		}
+	}
+
+	return
+}
+
+// stdoutLog converts a single log record emitted by the stdoutlog exporter.
+func stdoutLog(raw map[string]json.RawMessage) (*batch, error) {
+	var record struct {
+		Timestamp         *time.Time
+		ObservedTimestamp *time.Time
+		Severity          int32
+		SeverityText      string
+		Body              stdoutValue
+		Attributes        []struct {
+			Key   string
+			Value stdoutValue
+		}
+		TraceID           string
+		SpanID            string
+		TraceFlags        string
+		Resource          []stdoutAttribute
+		Scope             stdoutScope
+		DroppedAttributes uint32
+	}
+
+	if e := remarshal(raw, &record); e != nil {
+		return nil, fmt.Errorf("unable to decode stdout log record: %w", e)
+	}
+
+	body, e := value(record.Body)
+	if e != nil {
+		return nil, e
+	}
+
+	lr := &logspb.LogRecord{
+		SeverityNumber:         logspb.SeverityNumber(record.Severity),
+		SeverityText:           record.SeverityText,
+		Body:                   body,
+		DroppedAttributesCount: record.DroppedAttributes,
+		Flags:                  flags(record.TraceFlags),
+		TraceId:                identifier(record.TraceID),
+		SpanId:                 identifier(record.SpanID),
+	}
+
+	if record.Timestamp != nil {
+		lr.TimeUnixNano = nanoseconds(*record.Timestamp)
+	}
+
+	if record.ObservedTimestamp != nil {
+		lr.ObservedTimeUnixNano = nanoseconds(*record.ObservedTimestamp)
+	}
+
+	for _, kv := range record.Attributes {
+		converted, e := value(kv.Value)
+		if e != nil {
+			return nil, e
+		}
+
+		lr.Attributes = append(lr.Attributes, &commonpb.KeyValue{Key: kv.Key, Value: converted})
+	}
+
+	r, e := resource(record.Resource)
+	if e != nil {
+		return nil, e
+	}
+
+	message := &logscollector.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{
+			{
+				Resource: r,
+				ScopeLogs: []*logspb.ScopeLogs{
+					{Scope: scope(record.Scope), SchemaUrl: record.Scope.SchemaURL, LogRecords: []*logspb.LogRecord{lr}},
+				},
+			},
+		},
+	}
+
+	return &batch{signal: logs, message: message, items: 1}, nil
+}
+
+// remarshal decodes the already-split top-level object into target.
+func remarshal(raw map[string]json.RawMessage, target any) error {
+	buffer, e := json.Marshal(raw)
+	if e != nil {
+		return e
+	}
+
+	return json.Unmarshal(buffer, target)
+}
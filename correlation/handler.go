@@ -0,0 +1,194 @@
+package correlation
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Options defines the record keys, or alternative rendering, of the span context added to log records.
+type Options struct {
+	// TraceID is the record key of the hex-encoded trace identifier.
+	//
+	// 	- The default is "trace_id".
+	TraceID string
+
+	// SpanID is the record key of the hex-encoded span identifier.
+	//
+	// 	- The default is "span_id".
+	SpanID string
+
+	// TraceFlags is the record key of the hex-encoded trace flags. An empty string omits the attribute.
+	//
+	// 	- The default is "trace_flags".
+	TraceFlags string
+
+	// Attributes optionally overrides how a valid span context is rendered into record attributes, in which case the key
+	// options are ignored. See [Datadog] and [GCP] for examples.
+	//
+	// 	- The default is nil.
+	Attributes func(sc trace.SpanContext) []slog.Attr
+}
+
+// defaults initializes and returns a default Options instance with predefined configuration settings.
+func defaults() *Options {
+	return &Options{
+		TraceID:    "trace_id",
+		SpanID:     "span_id",
+		TraceFlags: "trace_flags",
+	}
+}
+
+// Datadog configures [Options] to render span contexts using Datadog's log correlation attributes, "dd.trace_id" and
+// "dd.span_id", as decimal representations of the lower 64 bits of the trace identifier and of the span identifier.
+func Datadog(options *Options) {
+	options.Attributes = func(sc trace.SpanContext) []slog.Attr {
+		traceID, spanID := sc.TraceID(), sc.SpanID()
+
+		var lower, span uint64
+		for _, b := range traceID[8:] {
+			lower = lower<<8 | uint64(b)
+		}
+
+		for _, b := range spanID {
+			span = span<<8 | uint64(b)
+		}
+
+		return []slog.Attr{
+			slog.String("dd.trace_id", strconv.FormatUint(lower, 10)),
+			slog.String("dd.span_id", strconv.FormatUint(span, 10)),
+		}
+	}
+}
+
+// GCP returns a setting that configures [Options] to render span contexts using Google Cloud Logging's special fields for
+// the given project.
+func GCP(project string) func(options *Options) {
+	return func(options *Options) {
+		options.Attributes = func(sc trace.SpanContext) []slog.Attr {
+			return []slog.Attr{
+				slog.String("logging.googleapis.com/trace", fmt.Sprintf("projects/%s/traces/%s", project, sc.TraceID())),
+				slog.String("logging.googleapis.com/spanId", sc.SpanID().String()),
+				slog.Bool("logging.googleapis.com/trace_sampled", sc.IsSampled()),
+			}
+		}
+	}
+}
+
+// handler is a [slog.Handler] middleware adding the active span's context to every record.
+//
+// Groups, and the attributes added within them, are applied by the middleware rather than next, such that the span
+// context's attributes remain at the top level of the record.
+type handler struct {
+	next    slog.Handler
+	options *Options
+	groups  []group
+}
+
+// group is a group opened via [slog.Handler.WithGroup], and the attributes added within it.
+type group struct {
+	name       string
+	attributes []slog.Attr
+}
+
+// Handler wraps next such that every record handled within the context of a valid span carries its trace identifier, span
+// identifier and trace flags, using optional configuration [Options]. Records without an active span pass through unchanged.
+//
+// The attributes are always added at the top level of the record, even if the returned handler is qualified by
+// [slog.Handler.WithGroup], as log correlation requires; next should not itself have open groups.
+func Handler(next slog.Handler, settings ...func(options *Options)) slog.Handler {
+	// Construct the options configuration.
+	options := defaults()
+	for _, setting := range settings {
+		if setting != nil {
+			setting(options)
+		}
+	}
+
+	return &handler{next: next, options: options}
+}
+
+func (h *handler) attributes(sc trace.SpanContext) []slog.Attr {
+	if h.options.Attributes != nil {
+		return h.options.Attributes(sc)
+	}
+
+	attributes := []slog.Attr{
+		slog.String(h.options.TraceID, sc.TraceID().String()),
+		slog.String(h.options.SpanID, sc.SpanID().String()),
+	}
+
+	if h.options.TraceFlags != "" {
+		attributes = append(attributes, slog.String(h.options.TraceFlags, sc.TraceFlags().String()))
+	}
+
+	return attributes
+}
+
+func (h *handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *handler) Handle(ctx context.Context, record slog.Record) error {
+	var attributes []slog.Attr
+	if ctx != nil {
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+			attributes = h.attributes(sc)
+		}
+	}
+
+	if len(h.groups) == 0 {
+		if len(attributes) > 0 {
+			record = record.Clone()
+			record.AddAttrs(attributes...)
+		}
+
+		return h.next.Handle(ctx, record)
+	}
+
+	// Nest the record's attributes within the open groups, from the innermost outward.
+	var nested []slog.Attr
+	record.Attrs(func(attribute slog.Attr) bool {
+		nested = append(nested, attribute)
+		return true
+	})
+
+	for i := len(h.groups) - 1; i >= 0; i-- {
+		members := append(slices.Clone(h.groups[i].attributes), nested...)
+		nested = []slog.Attr{{Key: h.groups[i].name, Value: slog.GroupValue(members...)}}
+	}
+
+	grouped := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+	grouped.AddAttrs(attributes...)
+	grouped.AddAttrs(nested...)
+
+	return h.next.Handle(ctx, grouped)
+}
+
+func (h *handler) WithAttrs(attributes []slog.Attr) slog.Handler {
+	if len(attributes) == 0 {
+		return h
+	}
+
+	if len(h.groups) == 0 {
+		return &handler{next: h.next.WithAttrs(attributes), options: h.options}
+	}
+
+	groups := slices.Clone(h.groups)
+	last := &groups[len(groups)-1]
+	last.attributes = append(slices.Clone(last.attributes), attributes...)
+
+	return &handler{next: h.next, options: h.options, groups: groups}
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	return &handler{next: h.next, options: h.options, groups: append(slices.Clone(h.groups), group{name: name})}
+}
@@ -0,0 +1,139 @@
+package correlation_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/poly-gun/go-telemetry/correlation"
+)
+
+var sc = trace.NewSpanContext(trace.SpanContextConfig{
+	TraceID:    trace.TraceID{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00},
+	SpanID:     trace.SpanID{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2a},
+	TraceFlags: trace.FlagsSampled,
+})
+
+// record logs a single message through the handler built by configure, within ctx, and returns the decoded JSON record.
+func record(t *testing.T, ctx context.Context, configure func(handler slog.Handler) *slog.Logger) map[string]any {
+	t.Helper()
+
+	var buffer bytes.Buffer
+
+	configure(slog.NewJSONHandler(&buffer, nil)).InfoContext(ctx, "Message", slog.Int("id", 1))
+
+	var output map[string]any
+	if e := json.Unmarshal(buffer.Bytes(), &output); e != nil {
+		t.Fatalf("Unexpected Error While Decoding Record %q: %v", buffer.String(), e)
+	}
+
+	return output
+}
+
+func TestHandler(t *testing.T) {
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	t.Run("Telemetry-Correlation-Default-Keys", func(t *testing.T) {
+		output := record(t, ctx, func(h slog.Handler) *slog.Logger {
+			return slog.New(correlation.Handler(h))
+		})
+
+		expected := map[string]any{"trace_id": sc.TraceID().String(), "span_id": sc.SpanID().String(), "trace_flags": "01", "id": float64(1)}
+		for key, value := range expected {
+			if output[key] != value {
+				t.Errorf("Unexpected %s - Expected: %v, Received: %v", key, value, output[key])
+			}
+		}
+	})
+
+	t.Run("Telemetry-Correlation-Custom-Keys", func(t *testing.T) {
+		output := record(t, ctx, func(h slog.Handler) *slog.Logger {
+			return slog.New(correlation.Handler(h, func(options *correlation.Options) {
+				options.TraceID = "trace"
+				options.SpanID = "span"
+				options.TraceFlags = ""
+			}))
+		})
+
+		if output["trace"] != sc.TraceID().String() || output["span"] != sc.SpanID().String() {
+			t.Errorf("Unexpected Custom Keys: %v", output)
+		}
+
+		for _, key := range []string{"trace_id", "span_id", "trace_flags"} {
+			if _, ok := output[key]; ok {
+				t.Errorf("Unexpected Default Key %s: %v", key, output)
+			}
+		}
+	})
+
+	t.Run("Telemetry-Correlation-Datadog", func(t *testing.T) {
+		output := record(t, ctx, func(h slog.Handler) *slog.Logger {
+			return slog.New(correlation.Handler(h, correlation.Datadog))
+		})
+
+		if output["dd.trace_id"] != "256" || output["dd.span_id"] != "42" {
+			t.Errorf("Unexpected Datadog Attributes: %v", output)
+		}
+	})
+
+	t.Run("Telemetry-Correlation-GCP", func(t *testing.T) {
+		output := record(t, ctx, func(h slog.Handler) *slog.Logger {
+			return slog.New(correlation.Handler(h, correlation.GCP("project")))
+		})
+
+		if output["logging.googleapis.com/trace"] != "projects/project/traces/"+sc.TraceID().String() {
+			t.Errorf("Unexpected GCP Trace: %v", output)
+		}
+
+		if output["logging.googleapis.com/spanId"] != sc.SpanID().String() || output["logging.googleapis.com/trace_sampled"] != true {
+			t.Errorf("Unexpected GCP Attributes: %v", output)
+		}
+	})
+
+	t.Run("Telemetry-Correlation-No-Span", func(t *testing.T) {
+		output := record(t, context.Background(), func(h slog.Handler) *slog.Logger {
+			return slog.New(correlation.Handler(h)).WithGroup("request")
+		})
+
+		for _, key := range []string{"trace_id", "span_id", "trace_flags"} {
+			if _, ok := output[key]; ok {
+				t.Errorf("Unexpected Key %s Without Span: %v", key, output)
+			}
+		}
+
+		if group, ok := output["request"].(map[string]any); !(ok) || group["id"] != float64(1) {
+			t.Errorf("Unexpected Group: %v", output)
+		}
+	})
+
+	t.Run("Telemetry-Correlation-With-Group", func(t *testing.T) {
+		output := record(t, ctx, func(h slog.Handler) *slog.Logger {
+			return slog.New(correlation.Handler(h, correlation.Datadog)).With("service", "api").WithGroup("request").With("method", "GET").WithGroup("user")
+		})
+
+		if output["dd.trace_id"] != "256" || output["dd.span_id"] != "42" {
+			t.Errorf("Expected Top-Level Correlation Attributes: %v", output)
+		}
+
+		if output["service"] != "api" {
+			t.Errorf("Expected Top-Level Attribute: %v", output)
+		}
+
+		request, ok := output["request"].(map[string]any)
+		if !(ok) || request["method"] != "GET" {
+			t.Fatalf("Unexpected Request Group: %v", output)
+		}
+
+		if _, ok := request["dd.trace_id"]; ok {
+			t.Errorf("Unexpected Grouped Correlation Attribute: %v", output)
+		}
+
+		if user, ok := request["user"].(map[string]any); !(ok) || user["id"] != float64(1) {
+			t.Errorf("Unexpected User Group: %v", output)
+		}
+	})
+}
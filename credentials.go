@@ -0,0 +1,155 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// Credentials provides the value of the "Authorization" header attached to every export request made by the trace, metric
+// and log exporters. Implementations are called once per request, and must be safe for concurrent use.
+type Credentials interface {
+	Authorization(ctx context.Context) (string, error)
+}
+
+// CredentialsFunc adapts an ordinary function to [Credentials].
+type CredentialsFunc func(ctx context.Context) (string, error)
+
+// Authorization calls f(ctx).
+func (f CredentialsFunc) Authorization(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// TokenFile represents [Credentials] read from a file, such as a projected Kubernetes service-account token. The file is
+// re-read whenever its modification time changes.
+type TokenFile struct {
+	// Path to the file containing the token. Leading and trailing whitespace is trimmed.
+	Path string
+
+	// Scheme is the authorization scheme prefixed to the token. Defaults to "Bearer".
+	Scheme string
+
+	mutex    sync.Mutex
+	modified time.Time
+	token    string
+}
+
+// Authorization returns the file's current token.
+func (t *TokenFile) Authorization(context.Context) (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	info, e := os.Stat(t.Path)
+	if e != nil {
+		if t.token != "" {
+			return t.header(), nil // The file may be mid-rotation; continue using the last known token.
+		}
+
+		return "", fmt.Errorf("unable to stat token file: %w", e)
+	}
+
+	if t.token == "" || !(info.ModTime().Equal(t.modified)) {
+		content, e := os.ReadFile(t.Path)
+		if e != nil {
+			return "", fmt.Errorf("unable to read token file: %w", e)
+		}
+
+		t.token = strings.TrimSpace(string(content))
+		t.modified = info.ModTime()
+	}
+
+	return t.header(), nil
+}
+
+func (t *TokenFile) header() string {
+	scheme := t.Scheme
+	if scheme == "" {
+		scheme = "Bearer"
+	}
+
+	return scheme + " " + t.token
+}
+
+// ClientCredentials represents [Credentials] obtained through the OAuth2 client-credentials flow. Tokens are cached and
+// refreshed shortly before they expire.
+type ClientCredentials struct {
+	// TokenURL is the authorization server's token endpoint.
+	TokenURL string
+
+	// ClientID is the application's identifier.
+	ClientID string
+
+	// ClientSecret is the application's secret.
+	ClientSecret string
+
+	// Scopes optionally specifies a list of requested permission scopes.
+	Scopes []string
+
+	// Parameters specifies additional parameters for requests to the token endpoint, e.g. "audience".
+	Parameters url.Values
+
+	// Client is an optional [http.Client] used for requests to the token endpoint. Defaults to [http.DefaultClient].
+	Client *http.Client
+
+	once   sync.Once
+	source oauth2.TokenSource
+}
+
+// Authorization returns a valid access token, requesting a new one if the cached token is absent or expired.
+func (c *ClientCredentials) Authorization(ctx context.Context) (string, error) {
+	c.once.Do(func() {
+		configuration := &clientcredentials.Config{
+			ClientID:       c.ClientID,
+			ClientSecret:   c.ClientSecret,
+			TokenURL:       c.TokenURL,
+			Scopes:         c.Scopes,
+			EndpointParams: c.Parameters,
+		}
+
+		// The token source outlives the request that first created it.
+		ctx := context.WithoutCancel(ctx)
+		if c.Client != nil {
+			ctx = context.WithValue(ctx, oauth2.HTTPClient, c.Client)
+		}
+
+		c.source = configuration.TokenSource(ctx)
+	})
+
+	token, e := c.source.Token()
+	if e != nil {
+		return "", fmt.Errorf("unable to retrieve oauth2 token: %w", e)
+	}
+
+	return token.Type() + " " + token.AccessToken, nil
+}
+
+// authorization is an [http.RoundTripper] setting the "Authorization" header on every request.
+type authorization struct {
+	base        http.RoundTripper
+	credentials Credentials
+}
+
+func (a *authorization) RoundTrip(r *http.Request) (*http.Response, error) {
+	value, e := a.credentials.Authorization(r.Context())
+	if e != nil {
+		if r.Body != nil {
+			_ = r.Body.Close()
+		}
+
+		return nil, fmt.Errorf("unable to resolve exporter credentials: %w", e)
+	}
+
+	// A RoundTripper must not modify the original request.
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", value)
+
+	return a.base.RoundTrip(r)
+}
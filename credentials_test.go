@@ -0,0 +1,227 @@
+package telemetry_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+func TestCredentials(t *testing.T) {
+	// pipeline sets up telemetry exporting to a local collector, returning a function that exports a single span and
+	// reports the "Authorization" header the collector received.
+	pipeline := func(t *testing.T, credentials telemetry.Credentials) (export func() string) {
+		t.Helper()
+
+		ctx := context.Background()
+
+		var mutex sync.Mutex
+		var headers []string
+
+		collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if r.URL.Path == "/v1/traces" {
+				headers = append(headers, r.Header.Get("Authorization"))
+			}
+
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		t.Cleanup(collector.Close)
+
+		shutdown := telemetry.Setup(ctx, func(options *telemetry.Settings) {
+			options.Zipkin.Enabled = false // disabled during testing
+
+			options.Transport = &telemetry.Transport{
+				Endpoint: collector.Listener.Addr().String(),
+				Insecure: true,
+			}
+
+			options.Credentials = credentials
+		})
+
+		t.Cleanup(func() { _ = shutdown(ctx) })
+
+		provider := otel.GetTracerProvider().(*trace.TracerProvider)
+
+		return func() string {
+			_, span := otel.Tracer("credentials-test").Start(ctx, "credentials-test-span")
+			span.End()
+
+			if e := provider.ForceFlush(ctx); e != nil {
+				t.Fatalf("Unexpected Error While Flushing Traces: %v", e)
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if len(headers) == 0 {
+				t.Fatal("No Trace Export Requests Received")
+			}
+
+			return headers[len(headers)-1]
+		}
+	}
+
+	t.Run("Telemetry-Token-File", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "token")
+
+		write := func(token string, modified time.Time) {
+			if e := os.WriteFile(path, []byte(token+"\n"), 0o600); e != nil {
+				t.Fatalf("Unexpected Error While Writing Token: %v", e)
+			}
+
+			if e := os.Chtimes(path, modified, modified); e != nil {
+				t.Fatalf("Unexpected Error While Updating Modification Time: %v", e)
+			}
+		}
+
+		write("token-1", time.Now())
+
+		export := pipeline(t, &telemetry.TokenFile{Path: path})
+
+		if header := export(); header != "Bearer token-1" {
+			t.Errorf("Unexpected Authorization Header: %q", header)
+		}
+
+		write("token-2", time.Now().Add(time.Minute))
+
+		if header := export(); header != "Bearer token-2" {
+			t.Errorf("Token Not Refreshed - Unexpected Authorization Header: %q", header)
+		}
+	})
+
+	t.Run("Telemetry-OAuth2-Client-Credentials", func(t *testing.T) {
+		var mutex sync.Mutex
+		var issued int
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id, secret, ok := r.BasicAuth()
+			if !(ok) || id != "client-id" || secret != "client-secret" || r.FormValue("grant_type") != "client_credentials" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			mutex.Lock()
+			issued++
+			token := fmt.Sprintf("access-token-%d", issued)
+			mutex.Unlock()
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"access_token": token, "token_type": "bearer", "expires_in": 3600})
+		}))
+
+		defer server.Close()
+
+		export := pipeline(t, &telemetry.ClientCredentials{
+			TokenURL:     server.URL,
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+		})
+
+		for range 2 {
+			if header := export(); header != "Bearer access-token-1" {
+				t.Errorf("Unexpected Authorization Header: %q", header)
+			}
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if issued != 1 {
+			t.Errorf("Expected Token to be Cached - Tokens Issued: %d", issued)
+		}
+	})
+
+	t.Run("Telemetry-Credentials-Function", func(t *testing.T) {
+		export := pipeline(t, telemetry.CredentialsFunc(func(ctx context.Context) (string, error) {
+			return "Custom secret", nil
+		}))
+
+		if header := export(); header != "Custom secret" {
+			t.Errorf("Unexpected Authorization Header: %q", header)
+		}
+	})
+
+	t.Run("Telemetry-Credentials-Transport-TLS", func(t *testing.T) {
+		ctx := context.Background()
+
+		ca := new(authority)
+		root, _ := ca.issue(t, "test-authority", x509.ExtKeyUsageAny)
+		serverCertificate, serverKey := ca.issue(t, "test-collector", x509.ExtKeyUsageServerAuth)
+
+		authorityPath := filepath.Join(t.TempDir(), "ca.crt")
+		if e := os.WriteFile(authorityPath, root, 0o600); e != nil {
+			t.Fatalf("Unexpected Error While Writing Certificate Authority: %v", e)
+		}
+
+		pair, e := tls.X509KeyPair(serverCertificate, serverKey)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Loading Server Certificate: %v", e)
+		}
+
+		var mutex sync.Mutex
+		var headers []string
+
+		collector := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if r.URL.Path == "/v1/traces" {
+				headers = append(headers, r.Header.Get("Authorization"))
+			}
+
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		collector.TLS = &tls.Config{Certificates: []tls.Certificate{pair}}
+
+		collector.StartTLS()
+		defer collector.Close()
+
+		// The credentials' client carries the transport's TLS configuration.
+		shutdown := telemetry.Setup(ctx, func(options *telemetry.Settings) {
+			options.Zipkin.Enabled = false // disabled during testing
+
+			options.Transport = &telemetry.Transport{
+				Endpoint: collector.Listener.Addr().String(),
+				CA:       authorityPath,
+			}
+
+			options.Credentials = telemetry.CredentialsFunc(func(ctx context.Context) (string, error) {
+				return "Bearer secret", nil
+			})
+		})
+
+		defer shutdown(ctx)
+
+		_, span := otel.Tracer("credentials-test").Start(ctx, "credentials-test-span")
+		span.End()
+
+		if e := otel.GetTracerProvider().(*trace.TracerProvider).ForceFlush(ctx); e != nil {
+			t.Fatalf("Unexpected Error While Flushing Traces: %v", e)
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if len(headers) != 1 || headers[0] != "Bearer secret" {
+			t.Errorf("Unexpected Authorization Headers Over TLS: %v", headers)
+		}
+	})
+}
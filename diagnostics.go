@@ -0,0 +1,518 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// pipeline is the state of the providers created by [Setup], used for flushing and diagnostics.
+type pipeline struct {
+	settings *Settings
+	resource *resource.Resource
+
+	tracer *trace.TracerProvider
+	meter  *metric.MeterProvider
+	logger *log.LoggerProvider
+
+	// shutdown is the function returned by [Setup].
+	shutdown func(context.Context) error
+
+	mutex    sync.Mutex
+	monitors []*monitor
+
+	// open maps the span ID of started, but not yet ended, spans to their name and start time; see [pipeline.track].
+	open     sync.Map
+	tracking sync.Once
+	opened   atomic.Int64
+	overflow atomic.Int64
+}
+
+// active is the pipeline created by the most recent call to [Setup].
+var active atomic.Pointer[pipeline]
+
+// diagnosing is the number of running [Diagnostics] handlers.
+var diagnosing atomic.Int64
+
+// capacity bounds the number of open spans tracked for diagnostics, as spans that are never ended are never released.
+const capacity = 4096
+
+// track registers, once, the processor tracking the pipeline's open spans. Only spans started afterward are tracked.
+func (p *pipeline) track() {
+	if p == nil {
+		return
+	}
+
+	p.tracking.Do(func() {
+		p.tracer.RegisterSpanProcessor(&tracked{pipeline: p})
+	})
+}
+
+// flush force-flushes the tracer, meter and logger providers, in that order. Each signal's error is prefixed by its name.
+//
+// Batching processors don't return export errors from a flush, therefore the signal's exporter monitors are consulted
+// for exports that failed during the flush.
+func (p *pipeline) flush(ctx context.Context) error {
+	var e error
+
+	start := time.Now()
+
+	for _, provider := range []struct {
+		signal string
+		flush  func(context.Context) error
+	}{
+		{"traces", p.tracer.ForceFlush},
+		{"metrics", p.meter.ForceFlush},
+		{"logs", p.logger.ForceFlush},
+	} {
+		err := provider.flush(ctx)
+		if err == nil {
+			err = p.failure(provider.signal, start)
+		}
+
+		if err != nil {
+			e = errors.Join(e, fmt.Errorf("unable to flush %s: %w", provider.signal, err))
+		}
+	}
+
+	return e
+}
+
+// failure returns the joined errors of the signal's exporters whose most recent export, since start, failed.
+func (p *pipeline) failure(signal string, start time.Time) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	var e error
+	for _, m := range p.monitors {
+		if m.signal != signal {
+			continue
+		}
+
+		m.mutex.Lock()
+		if m.e != nil && m.last.After(start) {
+			e = errors.Join(e, m.e)
+		}
+		m.mutex.Unlock()
+	}
+
+	return e
+}
+
+// monitor registers a new [monitor] of the signal's exporter.
+func (p *pipeline) monitor(signal string, exporter any) *monitor {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	m := &monitor{signal: signal, name: fmt.Sprintf("%s/%s", signal, reflect.TypeOf(exporter).String())}
+
+	p.monitors = append(p.monitors, m)
+
+	return m
+}
+
+// monitor tracks the health of an exporter and the approximate depth of the queue feeding it.
+type monitor struct {
+	signal, name string
+
+	queued, exported, failed atomic.Int64
+	batches, failures        atomic.Int64
+
+	mutex sync.Mutex
+	last  time.Time
+	e     error
+}
+
+// observe records the outcome of an export of n items.
+func (m *monitor) observe(n int, e error) {
+	m.batches.Add(1)
+	if e != nil {
+		m.failures.Add(1)
+		m.failed.Add(int64(n))
+	} else {
+		m.exported.Add(int64(n))
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.last, m.e = time.Now(), e
+}
+
+// report returns the monitor's state as a log attribute.
+func (m *monitor) report() slog.Attr {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	attributes := []any{
+		slog.Int64("exports", m.batches.Load()),
+		slog.Int64("failures", m.failures.Load()),
+		slog.Int64("exported", m.exported.Load()),
+		slog.Int64("dropped", m.failed.Load()),
+	}
+
+	if queued := m.queued.Load(); queued > 0 {
+		attributes = append(attributes, slog.Int64("queue", queued-m.exported.Load()-m.failed.Load()))
+	}
+
+	if !(m.last.IsZero()) {
+		attributes = append(attributes, slog.Time("last-export", m.last))
+	}
+
+	if m.e != nil {
+		attributes = append(attributes, slog.String("error", m.e.Error()))
+	}
+
+	return slog.Group(m.name, attributes...)
+}
+
+// monitoredSpans is a [trace.SpanExporter] recording the outcome of each export.
+type monitoredSpans struct {
+	trace.SpanExporter
+	monitor *monitor
+}
+
+func (e *monitoredSpans) ExportSpans(ctx context.Context, spans []trace.ReadOnlySpan) error {
+	err := e.SpanExporter.ExportSpans(ctx, spans)
+
+	e.monitor.observe(len(spans), err)
+
+	return err
+}
+
+// queuedSpans is a [trace.SpanProcessor] counting the sampled spans handed to the next, queueing, processor.
+type queuedSpans struct {
+	trace.SpanProcessor
+	monitor *monitor
+}
+
+func (p *queuedSpans) OnEnd(s trace.ReadOnlySpan) {
+	if s.SpanContext().IsSampled() {
+		p.monitor.queued.Add(1)
+	}
+
+	p.SpanProcessor.OnEnd(s)
+}
+
+// monitoredRecords is a [log.Exporter] recording the outcome of each export.
+type monitoredRecords struct {
+	log.Exporter
+	monitor *monitor
+}
+
+func (e *monitoredRecords) Export(ctx context.Context, records []log.Record) error {
+	err := e.Exporter.Export(ctx, records)
+
+	e.monitor.observe(len(records), err)
+
+	return err
+}
+
+// queuedRecords is a [log.Processor] counting the records handed to the next, queueing, processor.
+type queuedRecords struct {
+	log.Processor
+	monitor *monitor
+}
+
+func (p *queuedRecords) OnEmit(ctx context.Context, record *log.Record) error {
+	p.monitor.queued.Add(1)
+
+	return p.Processor.OnEmit(ctx, record)
+}
+
+func (p *queuedRecords) Enabled(ctx context.Context, parameters log.EnabledParameters) bool {
+	if next, ok := p.Processor.(log.FilterProcessor); ok {
+		return next.Enabled(ctx, parameters)
+	}
+
+	return true
+}
+
+// monitoredMetrics is a [metric.Exporter] recording the outcome of each export.
+type monitoredMetrics struct {
+	metric.Exporter
+	monitor *monitor
+}
+
+func (e *monitoredMetrics) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	err := e.Exporter.Export(ctx, rm)
+
+	var n int
+	for _, sm := range rm.ScopeMetrics {
+		n += len(sm.Metrics)
+	}
+
+	e.monitor.observe(n, err)
+
+	return err
+}
+
+// logprocessor returns the log processor exporting to the monitored exporter, batching if batch is true, wrapped by [processor].
+func logprocessor(settings *Settings, exporter log.Exporter, batch bool) log.Processor {
+	m := settings.pipeline.monitor("logs", exporter)
+
+	monitored := &monitoredRecords{Exporter: exporter, monitor: m}
+
+	if !(batch) {
+		return processor(settings, log.NewSimpleProcessor(monitored))
+	}
+
+	return processor(settings, &queuedRecords{Processor: log.NewBatchProcessor(monitored), monitor: m})
+}
+
+// batcher returns the batching span processor of the monitored exporter.
+func batcher(settings *Settings, exporter trace.SpanExporter, timeout time.Duration) trace.SpanProcessor {
+	m := settings.pipeline.monitor("traces", exporter)
+
+	return &queuedSpans{
+		SpanProcessor: trace.NewBatchSpanProcessor(&monitoredSpans{SpanExporter: exporter, monitor: m}, trace.WithBatchTimeout(timeout)),
+		monitor:       m,
+	}
+}
+
+// reader returns the periodic reader of the monitored metrics exporter.
+func reader(settings *Settings, exporter metric.Exporter, interval time.Duration) metric.Reader {
+	return metric.NewPeriodicReader(&monitoredMetrics{Exporter: exporter, monitor: settings.pipeline.monitor("metrics", exporter)}, metric.WithInterval(interval))
+}
+
+// opened is the name and start time of an open span.
+type opened struct {
+	name  string
+	start time.Time
+}
+
+// tracked is a [trace.SpanProcessor] tracking up to [capacity] open spans for diagnostics; spans started beyond it are
+// only counted.
+type tracked struct {
+	pipeline *pipeline
+}
+
+func (p *tracked) OnStart(_ context.Context, s trace.ReadWriteSpan) {
+	if diagnosing.Load() == 0 {
+		return
+	}
+
+	if p.pipeline.opened.Add(1) > capacity {
+		p.pipeline.opened.Add(-1)
+		p.pipeline.overflow.Add(1)
+
+		return
+	}
+
+	p.pipeline.open.Store(s.SpanContext().SpanID(), opened{name: s.Name(), start: s.StartTime()})
+}
+
+func (p *tracked) OnEnd(s trace.ReadOnlySpan) {
+	if _, ok := p.pipeline.open.LoadAndDelete(s.SpanContext().SpanID()); ok {
+		p.pipeline.opened.Add(-1)
+	}
+}
+
+func (p *tracked) Shutdown(context.Context) error {
+	return nil
+}
+
+func (p *tracked) ForceFlush(context.Context) error {
+	return nil
+}
+
+// report returns the diagnostics report's attributes: effective settings, resource, exporter health and the open spans
+// started since diagnostics were enabled. Spans started beyond the tracking capacity are reported as untracked.
+func (p *pipeline) report() []any {
+	s := p.settings
+
+	settings := []any{
+		slog.Bool("tracer.local", s.Tracer.Local),
+		slog.Bool("metrics.local", s.Metrics.Local),
+		slog.Bool("logs.local", s.Logs.Local),
+		slog.Bool("zipkin.enabled", s.Zipkin.Enabled),
+		slog.Bool("redaction", s.Redactor != nil),
+		slog.Bool("inherit", s.Inherit),
+		slog.Any("propagation", otel.GetTextMapPropagator().Fields()),
+	}
+
+	if s.Zipkin.Enabled {
+		settings = append(settings, slog.String("zipkin.url", s.Zipkin.URL))
+	}
+
+	if s.Transport != nil {
+		settings = append(settings, slog.String("transport.endpoint", s.Transport.Endpoint), slog.Bool("transport.mtls", s.Transport.Certificate != ""), slog.Bool("transport.compression", s.Transport.Compression))
+	}
+
+	if s.Credentials != nil {
+		settings = append(settings, slog.String("credentials", reflect.TypeOf(s.Credentials).String()))
+	}
+
+	if s.Baggage != nil {
+		settings = append(settings, slog.Any("baggage", s.Baggage.Keys))
+	}
+
+	if s.Logs.Filter != nil {
+		settings = append(settings, slog.String("logs.minimum", s.Logs.Filter.Minimum("").String()))
+	}
+
+	var attributes []any
+	for _, kv := range p.resource.Attributes() {
+		attributes = append(attributes, slog.String(string(kv.Key), kv.Value.Emit()))
+	}
+
+	p.mutex.Lock()
+	exporters := make([]any, 0, len(p.monitors))
+	for _, m := range p.monitors {
+		exporters = append(exporters, m.report())
+	}
+	p.mutex.Unlock()
+
+	var open []opened
+	p.open.Range(func(_, value any) bool {
+		open = append(open, value.(opened))
+		return true
+	})
+
+	slices.SortFunc(open, func(a, b opened) int {
+		return a.start.Compare(b.start)
+	})
+
+	// Report the oldest open spans, the likeliest to be leaked.
+	oldest := make([]any, 0, 10)
+	for i, span := range open[:min(len(open), 10)] {
+		oldest = append(oldest, slog.Group(fmt.Sprintf("%d", i), slog.String("name", span.name), slog.Duration("age", time.Since(span.start))))
+	}
+
+	return []any{
+		slog.Group("settings", settings...),
+		slog.Group("resource", attributes...),
+		slog.Group("exporters", exporters...),
+		slog.Group("spans", slog.Int("open", len(open)), slog.Int64("untracked", p.overflow.Load()), slog.Group("oldest", oldest...)),
+	}
+}
+
+// DiagnosticsOptions represents the configuration of the signal handler created by [Diagnostics].
+type DiagnosticsOptions struct {
+	// Flush is the signal force-flushing all providers without shutting them down. Defaults to SIGUSR1; there's no default
+	// on Windows.
+	Flush os.Signal
+
+	// Report is the signal writing a diagnostics report to [DiagnosticsOptions.Logger]. Defaults to SIGUSR2; there's no
+	// default on Windows.
+	Report os.Signal
+
+	// Timeout bounds each flush. Defaults to 10 seconds.
+	Timeout time.Duration
+
+	// Logger receives the diagnostics report and flush outcomes. Defaults to [slog.Default] at the time of the signal.
+	Logger *slog.Logger
+}
+
+func (o *DiagnosticsOptions) defaults() {
+	if o.Flush == nil {
+		o.Flush = flushing
+	}
+
+	if o.Report == nil {
+		o.Report = reporting
+	}
+
+	if o.Timeout <= 0 {
+		o.Timeout = 10 * time.Second
+	}
+}
+
+// Diagnostics is a signal handler for debugging the pipeline created by the most recent call to [Setup] at runtime. Upon
+// [DiagnosticsOptions.Flush] all providers are force-flushed, rather than waiting for their batch timeouts; upon
+// [DiagnosticsOptions.Report] a report of the effective settings, resource, exporter health, queue depths and open spans is
+// logged. The returned function stops handling the signals.
+//
+// Open spans are only tracked while a Diagnostics handler is running, and only those started after it began are reported.
+//
+// On Windows, which lacks the default signals, Diagnostics is a no-op unless the signals are configured.
+func Diagnostics(ctx context.Context, settings ...func(o *DiagnosticsOptions)) (stop func()) {
+	o := &DiagnosticsOptions{}
+	for _, configuration := range settings {
+		configuration(o)
+	}
+
+	o.defaults()
+
+	var signals []os.Signal
+	for _, s := range []os.Signal{o.Flush, o.Report} {
+		if s != nil {
+			signals = append(signals, s)
+		}
+	}
+
+	if len(signals) == 0 {
+		return func() {}
+	}
+
+	// Open spans are only tracked while diagnostics are enabled.
+	diagnosing.Add(1)
+	active.Load().track()
+
+	listener := make(chan os.Signal, 1)
+	signal.Notify(listener, signals...)
+
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case s := <-listener:
+				logger := o.Logger
+				if logger == nil {
+					logger = slog.Default()
+				}
+
+				p := active.Load()
+				if p == nil {
+					logger.WarnContext(ctx, "Telemetry Pipeline Not Initialized", slog.String("signal", s.String()))
+					continue
+				}
+
+				switch s {
+				case o.Flush:
+					flush, cancel := context.WithTimeout(ctx, o.Timeout)
+
+					start := time.Now()
+					if e := p.flush(flush); e != nil {
+						logger.ErrorContext(ctx, "Exception During Telemetry Pipeline Flush", slog.String("error", e.Error()))
+					} else {
+						logger.InfoContext(ctx, "Telemetry Pipeline Flushed", slog.Duration("duration", time.Since(start)))
+					}
+
+					cancel()
+				case o.Report:
+					logger.InfoContext(ctx, "Telemetry Pipeline Diagnostics", p.report()...)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			signal.Stop(listener)
+			close(done)
+
+			diagnosing.Add(-1)
+		})
+	}
+}
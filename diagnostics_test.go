@@ -0,0 +1,73 @@
+//go:build !windows
+
+package telemetry_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+func TestDiagnostics(t *testing.T) {
+	t.Run("Telemetry-Signal-Flush-And-Report", func(t *testing.T) {
+		ctx := context.Background()
+
+		traces, report := new(buffer), new(buffer)
+
+		shutdown := local(t, traces, io.Discard)
+
+		defer shutdown(ctx)
+
+		// Spans started before diagnostics are enabled aren't tracked.
+		_, untracked := otel.Tracer("github.com/application").Start(ctx, "Untracked Span")
+		defer untracked.End()
+
+		stop := telemetry.Diagnostics(ctx, func(o *telemetry.DiagnosticsOptions) {
+			o.Logger = slog.New(slog.NewJSONHandler(report, nil))
+		})
+
+		defer stop()
+
+		_, finished := otel.Tracer("github.com/application").Start(ctx, "Finished Span")
+		finished.End()
+
+		_, leaked := otel.Tracer("github.com/application").Start(ctx, "Leaked Span")
+		defer leaked.End()
+
+		if e := syscall.Kill(os.Getpid(), syscall.SIGUSR1); e != nil {
+			t.Fatalf("Unexpected Error While Signaling Process: %v", e)
+		}
+
+		// The batch timeout is 5 seconds; the flush exports the span well before it.
+		if !(eventually(2*time.Second, func() bool { return strings.Contains(traces.String(), "Finished Span") })) {
+			t.Fatal("Span Not Exported Upon Flush Signal")
+		}
+
+		if e := syscall.Kill(os.Getpid(), syscall.SIGUSR2); e != nil {
+			t.Fatalf("Unexpected Error While Signaling Process: %v", e)
+		}
+
+		if !(eventually(2*time.Second, func() bool { return strings.Contains(report.String(), "Telemetry Pipeline Diagnostics") })) {
+			t.Fatalf("Diagnostics Report Not Written:\n%s", report.String())
+		}
+
+		for _, expected := range []string{`"open":1`, `"name":"Leaked Span"`, `"traces/*stdouttrace.Exporter":{"exports":1`, `"queue":0`, `"service.name"`, `"tracer.local":true`} {
+			if !(strings.Contains(report.String(), expected)) {
+				t.Errorf("Diagnostics Report Missing %s:\n%s", expected, report.String())
+			}
+		}
+
+		if strings.Contains(report.String(), "Untracked Span") {
+			t.Errorf("Diagnostics Report Includes Span Started Before Diagnostics:\n%s", report.String())
+		}
+	})
+}
@@ -0,0 +1,14 @@
+//go:build !windows
+
+package telemetry
+
+import (
+	"os"
+	"syscall"
+)
+
+// flushing and reporting are the default signals of [Diagnostics].
+var (
+	flushing  os.Signal = syscall.SIGUSR1
+	reporting os.Signal = syscall.SIGUSR2
+)
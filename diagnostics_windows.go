@@ -0,0 +1,13 @@
+//go:build windows
+
+package telemetry
+
+import (
+	"os"
+)
+
+// flushing and reporting are the default signals of [Diagnostics]; Windows has no user-defined signals.
+var (
+	flushing  os.Signal
+	reporting os.Signal
+)
@@ -0,0 +1,162 @@
+package telemetry_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
+	collector "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metrics "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+// scrape returns the registry's OpenMetrics exposition, the only Prometheus format including exemplars.
+func scrape(t *testing.T, registry *prometheus.Registry) string {
+	t.Helper()
+
+	request := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	request.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+
+	recorder := httptest.NewRecorder()
+
+	promhttp.HandlerFor(registry, promhttp.HandlerOpts{EnableOpenMetrics: true}).ServeHTTP(recorder, request)
+
+	return recorder.Body.String()
+}
+
+func TestExemplars(t *testing.T) {
+	t.Run("Telemetry-Trace-Based-Histogram-Exemplars", func(t *testing.T) {
+		ctx := context.Background()
+
+		var mutex sync.Mutex
+		var requests []*collector.ExportMetricsServiceRequest
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, e := io.ReadAll(r.Body)
+			if e != nil {
+				t.Errorf("Unexpected Error While Reading Request: %v", e)
+			}
+
+			request := new(collector.ExportMetricsServiceRequest)
+			if e := proto.Unmarshal(body, request); e != nil {
+				t.Errorf("Unexpected Error While Decoding Request: %v", e)
+			}
+
+			mutex.Lock()
+			requests = append(requests, request)
+			mutex.Unlock()
+
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		defer server.Close()
+
+		registry := prometheus.NewRegistry()
+
+		shutdown := local(t, io.Discard, io.Discard, func(options *telemetry.Settings) {
+			options.Metrics.Local = false
+			options.Metrics.Options = []otlpmetrichttp.Option{otlpmetrichttp.WithEndpointURL(server.URL + "/v1/metrics")}
+			options.Metrics.Exemplars = exemplar.TraceBasedFilter
+			options.Metrics.Prometheus = registry
+		})
+
+		histogram, e := otel.Meter("github.com/application").Float64Histogram("application.latency")
+		if e != nil {
+			t.Fatalf("Unexpected Error While Creating Histogram: %v", e)
+		}
+
+		ctx, span := otel.Tracer("github.com/application").Start(ctx, "Sampled Span")
+		histogram.Record(ctx, 0.25)
+		span.End()
+
+		histogram.Record(context.Background(), 0.5) // outside a span, hence never an exemplar
+
+		sc := span.SpanContext()
+		if !(sc.IsSampled()) {
+			t.Fatal("Expected Sampled Span")
+		}
+
+		t.Run("Prometheus", func(t *testing.T) {
+			exposition := scrape(t, registry)
+
+			for _, label := range []string{`trace_id="` + sc.TraceID().String() + `"`, `span_id="` + sc.SpanID().String() + `"`} {
+				if !(strings.Contains(exposition, label)) {
+					t.Errorf("Exposition Missing Exemplar Label %s:\n%s", label, exposition)
+				}
+			}
+		})
+
+		if e := shutdown(context.Background()); e != nil {
+			t.Fatalf("Unexpected Error During Shutdown: %v", e)
+		}
+
+		t.Run("OTLP", func(t *testing.T) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			var exemplars []*metrics.Exemplar
+			for _, request := range requests {
+				for _, rm := range request.GetResourceMetrics() {
+					for _, sm := range rm.GetScopeMetrics() {
+						for _, m := range sm.GetMetrics() {
+							if m.GetName() != "application.latency" {
+								continue
+							}
+
+							for _, point := range m.GetHistogram().GetDataPoints() {
+								exemplars = append(exemplars, point.GetExemplars()...)
+							}
+						}
+					}
+				}
+			}
+
+			if len(exemplars) != 1 {
+				t.Fatalf("Unexpected Number of Exemplars: %d", len(exemplars))
+			}
+
+			traceID, spanID := sc.TraceID(), sc.SpanID()
+			if !(bytes.Equal(exemplars[0].GetTraceId(), traceID[:])) || !(bytes.Equal(exemplars[0].GetSpanId(), spanID[:])) {
+				t.Errorf("Exemplar Not Linked to Span - Trace ID: %x, Span ID: %x", exemplars[0].GetTraceId(), exemplars[0].GetSpanId())
+			}
+		})
+	})
+
+	t.Run("Telemetry-Disabled-Exemplars", func(t *testing.T) {
+		ctx := context.Background()
+
+		registry := prometheus.NewRegistry()
+
+		shutdown := local(t, io.Discard, io.Discard, func(options *telemetry.Settings) {
+			options.Metrics.Exemplars = exemplar.AlwaysOffFilter
+			options.Metrics.Prometheus = registry
+		})
+
+		defer shutdown(ctx)
+
+		histogram, e := otel.Meter("github.com/application").Float64Histogram("application.latency")
+		if e != nil {
+			t.Fatalf("Unexpected Error While Creating Histogram: %v", e)
+		}
+
+		ctx, span := otel.Tracer("github.com/application").Start(ctx, "Sampled Span")
+		histogram.Record(ctx, 0.25)
+		span.End()
+
+		if exposition := scrape(t, registry); strings.Contains(exposition, "trace_id") {
+			t.Errorf("Unexpected Exemplar in Exposition:\n%s", exposition)
+		}
+	})
+}
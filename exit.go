@@ -0,0 +1,84 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/contrib/bridges/otelslog"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// LevelFatal is the [slog.Level] of records emitted by [Fatal], mapping to the OpenTelemetry FATAL severity.
+const LevelFatal = slog.Level(12)
+
+// Fatal logs a fatal-severity record through both the OpenTelemetry logger provider and the [slog.Default] handler, then
+// exits the process with code 1; see [Exit]. Unlike [log.Fatal], the telemetry pipeline is shut down before exiting.
+func Fatal(ctx context.Context, message string, arguments ...any) {
+	slog.Default().Log(ctx, LevelFatal, message, arguments...)
+
+	// The default slog handler only reaches the logger provider if the bridge is installed.
+	if p := active.Load(); p == nil || p.settings.Logs.Bridge == nil {
+		slog.New(otelslog.NewHandler("github.com/poly-gun/go-telemetry")).Log(ctx, LevelFatal, message, arguments...)
+	}
+
+	if span := trace.SpanFromContext(ctx); span.IsRecording() {
+		span.SetStatus(codes.Error, message)
+	}
+
+	Exit(ctx, 1)
+}
+
+// Exit shuts the application and telemetry pipeline down before exiting the process with the given code, as [os.Exit]
+// bypasses deferred shutdowns. For non-zero codes, the context's active span is marked as failed. The span is ended so
+// it's exported.
+//
+// The hooks registered via [OnShutdown] run first, then the shutdown function returned by the most recent call to [Setup],
+// both bounded by [Settings.Grace]. As with [Interrupt], the process is forcefully exited once the grace period expires.
+//
+// Only the [OnShutdown] hooks run: a [Lifecycle] passed via [InterruptOptions.Lifecycle] is unknown to Exit, so call its
+// [Lifecycle.Shutdown] beforehand, or register its hooks via [OnShutdown] instead.
+func Exit(ctx context.Context, code int) {
+	if span := trace.SpanFromContext(ctx); span.IsRecording() {
+		if code != 0 {
+			span.SetStatus(codes.Error, fmt.Sprintf("exit status %d", code))
+		}
+
+		span.End()
+	}
+
+	p := active.Load()
+	if p == nil {
+		os.Exit(code)
+	}
+
+	grace := p.settings.Grace
+	if grace <= 0 {
+		grace = 30 * time.Second
+	}
+
+	handler, timeout := context.WithTimeout(context.WithoutCancel(ctx), grace)
+	defer timeout()
+	go func() {
+		<-handler.Done()
+		if errors.Is(handler.Err(), context.DeadlineExceeded) {
+			slog.Log(ctx, slog.LevelError, "Graceful Telemetry Pipeline Shutdown Timeout - Forcing an Exit ...")
+
+			os.Exit(code)
+		}
+	}()
+
+	if e := lifecycle.Shutdown(handler); e != nil {
+		slog.ErrorContext(ctx, "Exception During Application Shutdown", slog.String("error", e.Error()))
+	}
+
+	if e := p.shutdown(handler); e != nil {
+		slog.ErrorContext(ctx, "Exception During Telemetry Pipeline Shutdown", slog.String("error", e.Error()))
+	}
+
+	os.Exit(code)
+}
@@ -0,0 +1,74 @@
+package telemetry_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+func TestFatal(t *testing.T) {
+	// The child process writes its telemetry to the files in the directory, then exits via [telemetry.Fatal].
+	if directory := os.Getenv("TELEMETRY_FATAL_DIRECTORY"); directory != "" {
+		ctx := context.Background()
+
+		traces, _ := os.Create(filepath.Join(directory, "traces.json"))
+		logs, _ := os.Create(filepath.Join(directory, "logs.json"))
+
+		local(t, traces, logs)
+
+		telemetry.OnShutdown(telemetry.Hook{Name: "marker", Shutdown: func(context.Context) error {
+			return os.WriteFile(filepath.Join(directory, "hook"), nil, 0o600)
+		}})
+
+		ctx, _ = otel.Tracer("github.com/application").Start(ctx, "Fatal Span")
+
+		telemetry.Fatal(ctx, "Unrecoverable Configuration", "path", "/etc/application.yaml")
+
+		return
+	}
+
+	t.Run("Telemetry-Fatal-Exit", func(t *testing.T) {
+		directory := t.TempDir()
+
+		cmd := exec.Command(os.Args[0], "-test.run=^TestFatal$")
+		cmd.Env = append(os.Environ(), "TELEMETRY_FATAL_DIRECTORY="+directory)
+
+		output, e := cmd.CombinedOutput()
+
+		var exit *exec.ExitError
+		if !(errors.As(e, &exit)) || exit.ExitCode() != 1 {
+			t.Fatalf("Expected Exit Code 1, Received: %v\n%s", e, output)
+		}
+
+		if !(strings.Contains(string(output), "Unrecoverable Configuration")) {
+			t.Errorf("Default Handler Missing Fatal Record:\n%s", output)
+		}
+
+		read := func(name string) string {
+			content, e := os.ReadFile(filepath.Join(directory, name))
+			if e != nil {
+				t.Fatalf("Unexpected Error While Reading %s: %v", name, e)
+			}
+
+			return string(content)
+		}
+
+		if traces := read("traces.json"); !(strings.Contains(traces, "Fatal Span")) || !(strings.Contains(traces, `"Code": "Error"`)) {
+			t.Errorf("Traces Missing Failed Span:\n%s", traces)
+		}
+
+		if logs := read("logs.json"); !(strings.Contains(logs, "Unrecoverable Configuration")) || !(strings.Contains(logs, `"Severity": 21`)) {
+			t.Errorf("Logs Missing Fatal Record:\n%s", logs)
+		}
+
+		read("hook")
+	})
+}
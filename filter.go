@@ -0,0 +1,158 @@
+package telemetry
+
+import (
+	"context"
+	"sync"
+
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/log"
+)
+
+// SeverityFilter drops log records below a minimum severity. The minimum applies to every instrumentation scope (logger
+// name), unless overridden for scopes matching a glob pattern. Both can be changed at runtime.
+//
+// Patterns support "*", matching any sequence of characters (including "/"), and "?", matching a single character. When
+// several patterns match a scope, an exact match takes precedence, followed by the longest pattern; among patterns of equal
+// length, the lexically smallest is chosen.
+type SeverityFilter struct {
+	mutex   sync.RWMutex
+	minimum otellog.Severity
+	scopes  map[string]otellog.Severity
+}
+
+// NewSeverityFilter creates a [SeverityFilter] with a default minimum severity and optional per-scope overrides, keyed by glob pattern.
+func NewSeverityFilter(minimum otellog.Severity, scopes map[string]otellog.Severity) *SeverityFilter {
+	f := &SeverityFilter{minimum: minimum, scopes: make(map[string]otellog.Severity, len(scopes))}
+	for pattern, severity := range scopes {
+		f.scopes[pattern] = severity
+	}
+
+	return f
+}
+
+// SetMinimum changes the default minimum severity.
+func (f *SeverityFilter) SetMinimum(severity otellog.Severity) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.minimum = severity
+}
+
+// SetScope adds, or changes, the minimum severity of scopes matching pattern.
+func (f *SeverityFilter) SetScope(pattern string, severity otellog.Severity) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.scopes[pattern] = severity
+}
+
+// RemoveScope removes the override of pattern; matching scopes revert to the default minimum, or another matching pattern.
+func (f *SeverityFilter) RemoveScope(pattern string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	delete(f.scopes, pattern)
+}
+
+// Minimum returns the effective minimum severity of the named scope.
+func (f *SeverityFilter) Minimum(scope string) otellog.Severity {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	if severity, found := f.scopes[scope]; found {
+		return severity
+	}
+
+	// Map iteration order is random; ties are broken lexically, for the outcome to be deterministic.
+	severity, chosen, length := f.minimum, "", -1
+	for pattern, s := range f.scopes {
+		if (len(pattern) > length || (len(pattern) == length && pattern < chosen)) && glob(pattern, scope) {
+			severity, chosen, length = s, pattern, len(pattern)
+		}
+	}
+
+	return severity
+}
+
+// glob reports whether name matches pattern, where "*" matches any sequence of characters and "?" a single character.
+func glob(pattern, name string) bool {
+	// Iterative wildcard matching with single-star backtracking.
+	p, n := 0, 0
+	star, match := -1, 0
+
+	for n < len(name) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == name[n]):
+			p++
+			n++
+		case p < len(pattern) && pattern[p] == '*':
+			star, match = p, n
+			p++
+		case star != -1:
+			p = star + 1
+			match++
+			n = match
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
+
+// filtered is a [log.Processor] forwarding only records satisfying a [SeverityFilter] to the next processor.
+type filtered struct {
+	filter *SeverityFilter
+	next   log.Processor
+}
+
+func (f *filtered) OnEmit(ctx context.Context, record *log.Record) error {
+	if record.Severity() < f.filter.Minimum(record.InstrumentationScope().Name) {
+		return nil
+	}
+
+	return f.next.OnEmit(ctx, record)
+}
+
+// Enabled implements [log.FilterProcessor], allowing loggers to skip constructing records that would be dropped.
+func (f *filtered) Enabled(ctx context.Context, parameters log.EnabledParameters) bool {
+	if parameters.Severity != otellog.SeverityUndefined && parameters.Severity < f.filter.Minimum(parameters.InstrumentationScope.Name) {
+		return false
+	}
+
+	if next, ok := f.next.(log.FilterProcessor); ok {
+		return next.Enabled(ctx, parameters)
+	}
+
+	return true
+}
+
+func (f *filtered) Shutdown(ctx context.Context) error {
+	return f.next.Shutdown(ctx)
+}
+
+func (f *filtered) ForceFlush(ctx context.Context) error {
+	return f.next.ForceFlush(ctx)
+}
+
+// processor wraps the exporting processor with the configured [Settings.Redactor], [Settings.Baggage] and [Logs.Filter], if
+// any. Records are filtered, then stamped with baggage, then redacted.
+func processor(settings *Settings, p log.Processor) log.Processor {
+	if settings.Redactor != nil {
+		p = &redactedRecords{redactor: settings.Redactor, next: p}
+	}
+
+	if settings.Baggage != nil {
+		p = &baggagedRecords{settings: settings.Baggage, next: p}
+	}
+
+	if settings.Logs.Filter == nil {
+		return p
+	}
+
+	return &filtered{filter: settings.Logs.Filter, next: p}
+}
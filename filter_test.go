@@ -0,0 +1,91 @@
+package telemetry_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/contrib/bridges/otelslog"
+	otellog "go.opentelemetry.io/otel/log"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+func TestSeverityFilter(t *testing.T) {
+	t.Run("Telemetry-Scope-Severity-Filter", func(t *testing.T) {
+		ctx := context.Background()
+
+		filter := telemetry.NewSeverityFilter(otellog.SeverityInfo, map[string]otellog.Severity{
+			"github.com/noisy/*":    otellog.SeverityError,
+			"github.com/noisy/tame": otellog.SeverityDebug,
+		})
+
+		var logs bytes.Buffer
+
+		shutdown := local(t, io.Discard, &logs, func(options *telemetry.Settings) {
+			options.Logs.Filter = filter
+		})
+
+		defer shutdown(ctx)
+
+		application := otelslog.NewLogger("github.com/application")
+		noisy := otelslog.NewLogger("github.com/noisy/library/v2")
+		tame := otelslog.NewLogger("github.com/noisy/tame")
+
+		application.DebugContext(ctx, "Application Debug Message")
+		application.InfoContext(ctx, "Application Info Message")
+		noisy.WarnContext(ctx, "Noisy Warning Message")
+		noisy.ErrorContext(ctx, "Noisy Error Message")
+		tame.DebugContext(ctx, "Tame Debug Message")
+
+		filter.SetScope("github.com/noisy/*", otellog.SeverityWarn)
+
+		noisy.WarnContext(ctx, "Noisy Runtime Warning Message")
+
+		expectations := map[string]bool{
+			"Application Debug Message":     false,
+			"Application Info Message":      true,
+			"Noisy Warning Message":         false,
+			"Noisy Error Message":           true,
+			"Tame Debug Message":            true,
+			"Noisy Runtime Warning Message": true,
+		}
+
+		for message, expected := range expectations {
+			if found := strings.Contains(logs.String(), message); found != expected {
+				t.Errorf("Message %q - Expected Exported: %v, Exported: %v", message, expected, found)
+			}
+		}
+
+		if noisy.Enabled(ctx, slog.LevelDebug) {
+			t.Error("Expected Logger to Report Debug Level as Disabled")
+		}
+	})
+
+	t.Run("Telemetry-Scope-Severity-Filter-Ties", func(t *testing.T) {
+		// Equal-length patterns matching the same scope resolve lexically, regardless of map iteration order.
+		for range 100 {
+			filter := telemetry.NewSeverityFilter(otellog.SeverityInfo, map[string]otellog.Severity{
+				"foo/*":     otellog.SeverityError,
+				"*/bar":     otellog.SeverityDebug,
+				"f?o/*":     otellog.SeverityWarn,
+				"foo/b*":    otellog.SeverityTrace,
+				"*":         otellog.SeverityFatal,
+				"other/bar": otellog.SeverityFatal,
+			})
+
+			if minimum := filter.Minimum("foo/bar"); minimum != otellog.SeverityTrace {
+				t.Fatalf("Expected Longest Pattern's Severity, Received: %s", minimum)
+			}
+
+			filter.RemoveScope("foo/b*")
+
+			if minimum := filter.Minimum("foo/bar"); minimum != otellog.SeverityDebug {
+				t.Fatalf("Expected Lexically Smallest Pattern's Severity, Received: %s", minimum)
+			}
+		}
+	})
+}
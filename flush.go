@@ -0,0 +1,21 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotInitialized is returned by [Flush] when [Setup] hasn't been called.
+var ErrNotInitialized = errors.New("telemetry pipeline not initialized")
+
+// Flush force-flushes the tracer, meter and logger providers created by the most recent call to [Setup], exporting all
+// buffered spans, metrics and log records without shutting the pipeline down; e.g. in short-lived jobs and tests. The
+// returned error joins the errors of each failed signal, prefixed by the signal's name.
+func Flush(ctx context.Context) error {
+	p := active.Load()
+	if p == nil {
+		return ErrNotInitialized
+	}
+
+	return p.flush(ctx)
+}
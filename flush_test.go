@@ -0,0 +1,56 @@
+package telemetry_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/contrib/bridges/otelslog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+func TestFlush(t *testing.T) {
+	t.Run("Telemetry-Force-Flush-All-Signals", func(t *testing.T) {
+		ctx := context.Background()
+
+		var traces, metrics bytes.Buffer
+
+		shutdown := local(t, &traces, io.Discard, func(options *telemetry.Settings) {
+			options.Metrics.Writer = &metrics
+
+			// An unreachable collector, causing the log signal's flush to fail.
+			options.Logs.Local = false
+			options.Logs.Options = []otlploghttp.Option{otlploghttp.WithEndpointURL("http://127.0.0.1:1/v1/logs"), otlploghttp.WithRetry(otlploghttp.RetryConfig{Enabled: false})}
+		})
+
+		defer shutdown(ctx)
+
+		_, span := otel.Tracer("github.com/application").Start(ctx, "Flushed Span")
+		span.End()
+
+		counter, _ := otel.Meter("github.com/application").Int64Counter("application.flushed")
+		counter.Add(ctx, 1)
+
+		otelslog.NewLogger("github.com/application").InfoContext(ctx, "Flushed Message")
+
+		e := telemetry.Flush(ctx)
+
+		if !(strings.Contains(traces.String(), "Flushed Span")) {
+			t.Error("Span Not Exported Upon Flush")
+		}
+
+		if !(strings.Contains(metrics.String(), "application.flushed")) {
+			t.Error("Metric Not Exported Upon Flush")
+		}
+
+		if e == nil || !(strings.Contains(e.Error(), "unable to flush logs")) || strings.Contains(e.Error(), "traces") {
+			t.Errorf("Unexpected Flush Result: %v", e)
+		}
+
+	})
+}
@@ -0,0 +1,65 @@
+package telemetry_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+// buffer is a [bytes.Buffer] safe for concurrent use.
+type buffer struct {
+	mutex sync.Mutex
+	bytes.Buffer
+}
+
+func (b *buffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.Buffer.Write(p)
+}
+
+func (b *buffer) String() string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.Buffer.String()
+}
+
+// eventually polls condition until it's satisfied or the timeout elapses.
+func eventually(timeout time.Duration, condition func() bool) bool {
+	for deadline := time.Now().Add(timeout); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if condition() {
+			return true
+		}
+	}
+
+	return condition()
+}
+
+// local sets up a pipeline that writes traces and logs to the given writers, discards metrics and never reaches a collector;
+// settings are applied afterwards.
+func local(t *testing.T, traces, logs io.Writer, settings ...telemetry.Variadic) (shutdown func(context.Context) error) {
+	t.Helper()
+
+	defaults := func(options *telemetry.Settings) {
+		options.Zipkin.Enabled = false // disabled during testing
+
+		options.Tracer.Local = true
+		options.Tracer.Writer = traces
+		options.Metrics.Local = true
+		options.Metrics.Writer = io.Discard // prevent output from filling the test logs
+
+		options.Logs = &telemetry.Logs{
+			Local:  true,
+			Writer: logs,
+		}
+	}
+
+	return telemetry.Setup(context.Background(), append([]telemetry.Variadic{defaults}, settings...)...)
+}
@@ -13,42 +13,184 @@ import (
 	"golang.org/x/term"
 )
 
-// Interrupt is a graceful interrupt + signal handler for the telemetry pipeline.
-func Interrupt(ctx context.Context, cancel context.CancelFunc, shutdown func(context.Context) error) chan os.Signal {
+// InterruptOptions represents the configuration of the signal handler created by [NewInterrupt].
+type InterruptOptions struct {
+	// Signals triggering the graceful shutdown. Defaults to [syscall.SIGHUP], [syscall.SIGINT], [syscall.SIGTERM] and [syscall.SIGQUIT].
+	Signals []os.Signal
+
+	// Grace is the period the shutdown function is given to complete before the process is forcefully exited. Defaults to 30 seconds.
+	Grace time.Duration
+
+	// Code is the exit code used when the grace period expires. Defaults to 124.
+	Code int
+
+	// Force exits the process immediately upon receiving a second signal during the graceful shutdown. Default is true.
+	Force bool
+
+	// ForceCode is the exit code used when forcefully exiting upon a repeated signal. Defaults to 130.
+	ForceCode int
+
+	// Exit terminates the process with the given code. Defaults to [os.Exit].
+	Exit func(code int)
+
+	// Lifecycle is the registry of hooks run, within the grace period, before the telemetry pipeline's shutdown function.
+	// Defaults to the registry populated by [OnShutdown]. Unlike the default, a custom registry isn't run by [Exit] or [Fatal].
+	Lifecycle *Lifecycle
+}
+
+func (o *InterruptOptions) defaults() {
+	if len(o.Signals) == 0 {
+		o.Signals = []os.Signal{syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT}
+	}
+
+	if o.Grace <= 0 {
+		o.Grace = 30 * time.Second
+	}
+
+	if o.Code == 0 {
+		o.Code = 124 // For portability, 134 cannot be used.
+	}
+
+	if o.ForceCode == 0 {
+		o.ForceCode = 130
+	}
+
+	if o.Exit == nil {
+		o.Exit = os.Exit
+	}
+
+	if o.Lifecycle == nil {
+		o.Lifecycle = lifecycle
+	}
+}
+
+// Interruption is a running signal handler created by [NewInterrupt].
+type Interruption struct {
+	listener chan os.Signal
+	done     chan struct{}
+
+	signal os.Signal
+	e      error
+}
+
+// Listener returns the channel the handler receives signals on; sending a signal manually triggers the shutdown.
+func (i *Interruption) Listener() chan<- os.Signal {
+	return i.listener
+}
+
+// Done returns a channel that's closed once the shutdown function has returned.
+func (i *Interruption) Done() <-chan struct{} {
+	return i.done
+}
+
+// Wait blocks until the shutdown completes and returns its outcome; see [Interruption.Err].
+func (i *Interruption) Wait() error {
+	<-i.done
+
+	return i.e
+}
+
+// Err returns the error of the shutdown function, or nil if it succeeded or hasn't completed.
+func (i *Interruption) Err() error {
+	select {
+	case <-i.done:
+		return i.e
+	default:
+		return nil
+	}
+}
+
+// Signal returns the signal that triggered the shutdown, or nil if it hasn't completed.
+func (i *Interruption) Signal() os.Signal {
+	select {
+	case <-i.done:
+		return i.signal
+	default:
+		return nil
+	}
+}
+
+// NewInterrupt is a configurable, graceful signal handler for the telemetry pipeline. Upon receiving any of the configured
+// signals, the [InterruptOptions.Lifecycle] hooks and then the shutdown function are called with the grace period as their
+// deadline; afterward cancel is called and [Interruption.Done] is closed.
+func NewInterrupt(ctx context.Context, cancel context.CancelFunc, shutdown func(context.Context) error, settings ...func(o *InterruptOptions)) *Interruption {
+	o := &InterruptOptions{Force: true}
+	for _, configuration := range settings {
+		configuration(o)
+	}
+
+	o.defaults()
+
+	i := &Interruption{
+		listener: make(chan os.Signal, 1),
+		done:     make(chan struct{}),
+	}
+
 	// Listen for syscall signals for process to interrupt/quit.
-	interrupt := make(chan os.Signal, 1)
-	signal.Notify(interrupt, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+	signal.Notify(i.listener, o.Signals...)
 
 	go func() {
-		<-interrupt
+		i.signal = <-i.listener
 
 		if term.IsTerminal(int(os.Stdout.Fd())) {
 			fmt.Print("\r")
 		}
 
-		slog.DebugContext(ctx, "Initializing Telemetry Pipeline Shutdown ...")
+		slog.DebugContext(ctx, "Initializing Telemetry Pipeline Shutdown ...", slog.String("signal", i.signal.String()))
+
+		if o.Force {
+			go func() {
+				select {
+				case s := <-i.listener:
+					slog.WarnContext(ctx, "Repeated Signal During Telemetry Pipeline Shutdown - Forcing an Exit ...", slog.String("signal", s.String()))
 
-		// Shutdown signal with grace period of 30 seconds.
-		handler, timeout := context.WithTimeout(ctx, 30*time.Second)
+					o.Exit(o.ForceCode)
+				case <-i.done:
+				}
+			}()
+		}
+
+		// Shutdown signal with the configured grace period.
+		handler, timeout := context.WithTimeout(ctx, o.Grace)
 		defer timeout()
 		go func() {
 			<-handler.Done()
 			if errors.Is(handler.Err(), context.DeadlineExceeded) {
 				slog.Log(ctx, slog.LevelError, "Graceful Telemetry Pipeline Shutdown Timeout - Forcing an Exit ...")
 
-				os.Exit(124) // For portability, 134 cannot be used.
+				o.Exit(o.Code)
 			}
 		}()
 
+		// Stop the application's components first, so the telemetry they emit while stopping is still exported.
+		if e := o.Lifecycle.Shutdown(handler); e != nil {
+			slog.ErrorContext(ctx, "Exception During Application Shutdown", slog.String("error", e.Error()))
+
+			i.e = e
+		}
+
 		// Trigger graceful shutdown.
 		if e := shutdown(handler); e != nil {
 			slog.ErrorContext(ctx, "Exception During Telemetry Pipeline Shutdown", slog.String("error", e.Error()))
+
+			i.e = errors.Join(i.e, e)
 		}
 
 		slog.InfoContext(ctx, "Telemetry Pipeline Shutdown Complete")
 
+		signal.Stop(i.listener)
+
+		close(i.done)
+
 		cancel()
 	}()
 
-	return interrupt
+	return i
+}
+
+// Interrupt is a graceful interrupt + signal handler for the telemetry pipeline. See [NewInterrupt] for a configurable variant.
+func Interrupt(ctx context.Context, cancel context.CancelFunc, shutdown func(context.Context) error) chan os.Signal {
+	return NewInterrupt(ctx, cancel, shutdown, func(o *InterruptOptions) {
+		o.Force = false
+	}).listener
 }
@@ -2,6 +2,7 @@ package telemetry_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -49,6 +50,64 @@ func TestInterrupt(t *testing.T) {
 
 		<-ctx.Done()
 	})
+
+	t.Run("Telemetry-Interrupt-Grace-Period-Exit", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		codes := make(chan int, 1)
+
+		shutdown := func(ctx context.Context) error {
+			<-ctx.Done()
+
+			return ctx.Err()
+		}
+
+		interruption := telemetry.NewInterrupt(ctx, cancel, shutdown, func(o *telemetry.InterruptOptions) {
+			o.Grace = 100 * time.Millisecond
+			o.Code = 3
+			o.Exit = func(code int) { codes <- code }
+		})
+
+		interruption.Listener() <- syscall.SIGTERM
+
+		if code := <-codes; code != 3 {
+			t.Errorf("Unexpected Exit Code: %d", code)
+		}
+
+		if e := interruption.Wait(); !(errors.Is(e, context.DeadlineExceeded)) {
+			t.Errorf("Unexpected Shutdown Result: %v", e)
+		}
+	})
+
+	t.Run("Telemetry-Interrupt-Repeated-Signal-Force-Quit", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		codes := make(chan int, 1)
+		release := make(chan struct{})
+
+		shutdown := func(ctx context.Context) error {
+			<-release
+
+			return nil
+		}
+
+		interruption := telemetry.NewInterrupt(ctx, cancel, shutdown, func(o *telemetry.InterruptOptions) {
+			o.Exit = func(code int) { codes <- code }
+		})
+
+		interruption.Listener() <- syscall.SIGINT
+		interruption.Listener() <- syscall.SIGINT
+
+		if code := <-codes; code != 130 {
+			t.Errorf("Unexpected Exit Code: %d", code)
+		}
+
+		close(release)
+
+		if e := interruption.Wait(); e != nil {
+			t.Errorf("Unexpected Shutdown Result: %v", e)
+		}
+	})
 }
 
 func ExampleInterrupt() {
@@ -0,0 +1,48 @@
+//go:build !windows
+
+package telemetry_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+func TestInterruptSignal(t *testing.T) {
+	t.Run("Telemetry-Configurable-Interrupt", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		shutdown := func(ctx context.Context) error {
+			return errors.New("shutdown failure")
+		}
+
+		interruption := telemetry.NewInterrupt(ctx, cancel, shutdown, func(o *telemetry.InterruptOptions) {
+			o.Signals = []os.Signal{syscall.SIGUSR1}
+		})
+
+		if e := syscall.Kill(os.Getpid(), syscall.SIGUSR1); e != nil {
+			t.Fatalf("Unexpected Error While Signaling Process: %v", e)
+		}
+
+		select {
+		case <-interruption.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatal("Shutdown Not Triggered by Configured Signal")
+		}
+
+		<-ctx.Done()
+
+		if e := interruption.Wait(); e == nil || e.Error() != "shutdown failure" {
+			t.Errorf("Unexpected Shutdown Result: %v", e)
+		}
+
+		if interruption.Signal() != syscall.SIGUSR1 {
+			t.Errorf("Unexpected Signal: %v", interruption.Signal())
+		}
+	})
+}
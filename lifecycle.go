@@ -0,0 +1,148 @@
+package telemetry
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Phase orders groups of shutdown hooks; all hooks of a phase complete before the next phase starts.
+type Phase int
+
+const (
+	// Ingress hooks stop accepting new work, e.g. [http.Server.Shutdown].
+	Ingress Phase = iota
+
+	// Drain hooks wait for in-flight work to complete, e.g. worker pools and message consumers.
+	Drain
+
+	// Release hooks close the resources in-flight work depended upon, e.g. database pools.
+	Release
+)
+
+func (p Phase) String() string {
+	switch p {
+	case Ingress:
+		return "ingress"
+	case Drain:
+		return "drain"
+	case Release:
+		return "release"
+	}
+
+	return fmt.Sprintf("phase-%d", int(p))
+}
+
+// Hook is a named shutdown function registered with a [Lifecycle].
+type Hook struct {
+	// Name identifies the hook in logs and spans, e.g. "http-server".
+	Name string
+
+	// Phase during which the hook runs. Defaults to [Ingress].
+	Phase Phase
+
+	// Priority orders hooks within a phase; lower priorities run first. Hooks of equal priority run in registration order.
+	// Defaults to 0.
+	Priority int
+
+	// Timeout bounds the hook's execution, in addition to the deadline of the shutdown's context. Defaults to 0, i.e. no
+	// additional bound.
+	Timeout time.Duration
+
+	// Shutdown is the function called; it should return once the component is stopped or its context is done.
+	Shutdown func(ctx context.Context) error
+}
+
+// Lifecycle is an ordered registry of shutdown hooks, run before the telemetry pipeline's own shutdown so the spans and
+// logs they produce are still exported. See [OnShutdown] and [InterruptOptions.Lifecycle].
+type Lifecycle struct {
+	mutex sync.Mutex
+	hooks []Hook
+}
+
+// Register adds a hook to the lifecycle.
+func (l *Lifecycle) Register(hook Hook) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.hooks = append(l.hooks, hook)
+}
+
+// Shutdown runs the registered hooks ordered by phase then priority, each within its own span, and returns their joined
+// errors. A failing hook doesn't prevent subsequent ones from running. Each hook runs at most once.
+func (l *Lifecycle) Shutdown(ctx context.Context) error {
+	l.mutex.Lock()
+	hooks := l.hooks
+	l.hooks = nil
+	l.mutex.Unlock()
+
+	slices.SortStableFunc(hooks, func(a, b Hook) int {
+		if a.Phase != b.Phase {
+			return cmp.Compare(a.Phase, b.Phase)
+		}
+
+		return cmp.Compare(a.Priority, b.Priority)
+	})
+
+	var e error
+	for _, hook := range hooks {
+		e = errors.Join(e, run(ctx, hook))
+	}
+
+	return e
+}
+
+// run executes a single hook within a span, bounded by its timeout.
+func run(ctx context.Context, hook Hook) error {
+	ctx, span := otel.Tracer("github.com/poly-gun/go-telemetry").Start(ctx, fmt.Sprintf("shutdown %s", hook.Name))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("shutdown.hook", hook.Name), attribute.String("shutdown.phase", hook.Phase.String()), attribute.Int("shutdown.priority", hook.Priority))
+
+	if hook.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, hook.Timeout)
+		defer cancel()
+	}
+
+	attributes := []any{slog.String("hook", hook.Name), slog.String("phase", hook.Phase.String())}
+
+	slog.DebugContext(ctx, "Running Shutdown Hook ...", attributes...)
+
+	start := time.Now()
+
+	e := hook.Shutdown(ctx)
+	if e != nil {
+		e = fmt.Errorf("shutdown hook %q: %w", hook.Name, e)
+
+		span.RecordError(e)
+		span.SetStatus(codes.Error, e.Error())
+
+		slog.ErrorContext(ctx, "Exception During Shutdown Hook", append(attributes, slog.String("error", e.Error()))...)
+
+		return e
+	}
+
+	slog.DebugContext(ctx, "Shutdown Hook Complete", append(attributes, slog.Duration("duration", time.Since(start)))...)
+
+	return nil
+}
+
+// lifecycle is the default [Lifecycle] used by [OnShutdown] and the interrupt handlers.
+var lifecycle = new(Lifecycle)
+
+// OnShutdown registers a hook with the default [Lifecycle], run by [Interrupt] and [NewInterrupt] before the telemetry
+// pipeline's shutdown.
+func OnShutdown(hook Hook) {
+	lifecycle.Register(hook)
+}
@@ -0,0 +1,103 @@
+package telemetry_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math"
+	"slices"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+func TestLifecycle(t *testing.T) {
+	t.Run("Telemetry-Ordered-Shutdown-Hooks", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var traces bytes.Buffer
+
+		pipeline := local(t, &traces, io.Discard)
+
+		var order []string
+
+		record := func(name string) func(context.Context) error {
+			return func(context.Context) error {
+				order = append(order, name)
+				return nil
+			}
+		}
+
+		lifecycle := new(telemetry.Lifecycle)
+
+		lifecycle.Register(telemetry.Hook{Name: "database", Phase: telemetry.Release, Shutdown: record("database")})
+		lifecycle.Register(telemetry.Hook{Name: "workers", Phase: telemetry.Drain, Shutdown: record("workers")})
+		lifecycle.Register(telemetry.Hook{Name: "http-server", Phase: telemetry.Ingress, Priority: 1, Shutdown: record("http-server")})
+		lifecycle.Register(telemetry.Hook{Name: "health", Phase: telemetry.Ingress, Shutdown: record("health")})
+		lifecycle.Register(telemetry.Hook{Name: "consumer", Phase: telemetry.Drain, Timeout: 50 * time.Millisecond, Shutdown: func(ctx context.Context) error {
+			order = append(order, "consumer")
+
+			<-ctx.Done()
+
+			return ctx.Err()
+		}})
+
+		shutdown := func(ctx context.Context) error {
+			order = append(order, "telemetry")
+
+			return pipeline(ctx)
+		}
+
+		interruption := telemetry.NewInterrupt(ctx, cancel, shutdown, func(o *telemetry.InterruptOptions) {
+			o.Lifecycle = lifecycle
+		})
+
+		interruption.Listener() <- syscall.SIGTERM
+
+		e := interruption.Wait()
+		if !(errors.Is(e, context.DeadlineExceeded)) || !(strings.Contains(e.Error(), `"consumer"`)) {
+			t.Errorf("Unexpected Shutdown Result: %v", e)
+		}
+
+		expected := []string{"health", "http-server", "workers", "consumer", "database", "telemetry"}
+		if !(slices.Equal(order, expected)) {
+			t.Errorf("Unexpected Shutdown Order - Expected: %v, Received: %v", expected, order)
+		}
+
+		for _, name := range []string{"shutdown health", "shutdown consumer", "shutdown database"} {
+			if !(strings.Contains(traces.String(), name)) {
+				t.Errorf("Missing Span %q", name)
+			}
+		}
+	})
+
+	t.Run("Telemetry-Extreme-Priorities", func(t *testing.T) {
+		var order []string
+
+		record := func(name string) func(context.Context) error {
+			return func(context.Context) error {
+				order = append(order, name)
+				return nil
+			}
+		}
+
+		// Subtracting these priorities overflows.
+		lifecycle := new(telemetry.Lifecycle)
+
+		lifecycle.Register(telemetry.Hook{Name: "last", Priority: math.MaxInt, Shutdown: record("last")})
+		lifecycle.Register(telemetry.Hook{Name: "first", Priority: math.MinInt, Shutdown: record("first")})
+
+		if e := lifecycle.Shutdown(context.Background()); e != nil {
+			t.Fatalf("Unexpected Error During Shutdown: %v", e)
+		}
+
+		expected := []string{"first", "last"}
+		if !(slices.Equal(order, expected)) {
+			t.Errorf("Unexpected Shutdown Order - Expected: %v, Received: %v", expected, order)
+		}
+	})
+}
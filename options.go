@@ -2,16 +2,23 @@ package telemetry
 
 import (
 	"io"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
 	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/exporters/zipkin"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 )
 
+// endpoint is the default OTLP/HTTP collector "host:port".
+const endpoint = "opentelemetry-collector.observability.svc.cluster.local:4318"
+
 // Zipkin represents the configuration for a Zipkin collector.
 // URL specifies the Zipkin collector URL, defaulting to "http://opentelemetry-collector.observability.svc.cluster.local:9441".
 // Enabled determines if the Zipkin collector is active. Default is true.
@@ -21,6 +28,9 @@ type Zipkin struct {
 
 	// Enabled will enable the Zipkin collector. Default is true.
 	Enabled bool
+
+	// Options represents [zipkin.Option] configurations. Defaults nil.
+	Options []zipkin.Option
 }
 
 // Tracer represents a tracer configuration for OpenTelemetry.
@@ -41,6 +51,10 @@ type Tracer struct {
 
 	// Writer is an optional [io.Writer] for usage when [Tracer.Local] or [Tracer.Debugger] options are configured. Defaults to [os.Stdout].
 	Writer io.Writer
+
+	// Budget bounds the provider's shutdown, which runs in parallel with the other providers', in addition to the shutdown
+	// context's deadline. Defaults to 0, i.e. no additional bound.
+	Budget time.Duration
 }
 
 type Metrics struct {
@@ -60,6 +74,21 @@ type Metrics struct {
 
 	// Writer is an optional [io.Writer] for usage when [Metrics.Local] or [Metrics.Debugger] options are configured. Defaults to [os.Stdout].
 	Writer io.Writer
+
+	// Budget bounds the provider's shutdown, which runs in parallel with the other providers', in addition to the shutdown
+	// context's deadline. Defaults to 0, i.e. no additional bound.
+	Budget time.Duration
+
+	// Exemplars determines which measurements may be recorded as exemplars, linking metric points to the span active when
+	// they were measured: [exemplar.AlwaysOnFilter], [exemplar.TraceBasedFilter] (sampled spans only) or [exemplar.AlwaysOffFilter].
+	// Defaults nil, in which case the SDK's default applies: [exemplar.TraceBasedFilter], unless overridden by the
+	// OTEL_METRICS_EXEMPLAR_FILTER environment variable.
+	Exemplars exemplar.Filter
+
+	// Prometheus, if not nil, additionally registers a pull-based Prometheus exporter with the registry. Exemplars are only
+	// exposed in the OpenMetrics format, which requires serving the registry with promhttp.HandlerOpts{EnableOpenMetrics: true}.
+	// Defaults nil.
+	Prometheus *prometheus.Registry
 }
 
 type Logs struct {
@@ -79,6 +108,18 @@ type Logs struct {
 
 	// Writer is an optional [io.Writer] for usage when [Logs.Local] or [Logs.Debugger] options are configured. Defaults to [os.Stdout].
 	Writer io.Writer
+
+	// Budget bounds the provider's shutdown, which runs in parallel with the other providers', in addition to the shutdown
+	// context's deadline. Defaults to 0, i.e. no additional bound.
+	Budget time.Duration
+
+	// Bridge, if not nil, makes [Setup] install a [slog.Default] handler forwarding records to both the logger provider and a
+	// local console handler. The original default is restored on shutdown. Defaults nil.
+	Bridge *Bridge
+
+	// Filter, if not nil, drops records below a minimum severity, optionally overridden per instrumentation scope. The filter
+	// may be changed at runtime. Defaults nil.
+	Filter *SeverityFilter
 }
 
 type Settings struct {
@@ -94,6 +135,25 @@ type Settings struct {
 	// Logs represents [otlploghttp.Option] configurations.
 	Logs *Logs
 
+	// Transport represents TLS, mTLS and compression configurations applied to every exporter. Defaults nil, in which case
+	// each signal's Options apply unchanged.
+	Transport *Transport
+
+	// Credentials, if not nil, provide the "Authorization" header of every export request made by the trace, metric and log
+	// exporters; see [TokenFile], [ClientCredentials] and [CredentialsFunc]. Defaults nil.
+	//
+	// The exporters then send requests via a client of their own, taking precedence over the WithTLSClientConfig, WithProxy
+	// and WithTimeout options of [Tracer.Options], [Metrics.Options] and [Logs.Options]: TLS is configured via
+	// [Settings.Transport], proxies via the HTTPS_PROXY and HTTP_PROXY environment variables, and timeouts via the
+	// OTEL_EXPORTER_OTLP_TIMEOUT environment variable, or its per-signal equivalents, in milliseconds (defaulting to 10 seconds).
+	Credentials Credentials
+
+	// Redactor, if not nil, scrubs sensitive data from every exported span, log record and metric measurement. Defaults nil.
+	Redactor *Redactor
+
+	// Baggage, if not nil, copies allowlisted W3C baggage members onto every started span and emitted log record. Defaults nil.
+	Baggage *Baggage
+
 	// Propagators ...
 	//
 	// Defaults:
@@ -101,6 +161,22 @@ type Settings struct {
 	//	- [propagation.TraceContext]
 	//	- [propagation.Baggage]
 	Propagators []propagation.TextMapPropagator
+
+	// PropagatorNames, if not empty, replaces [Settings.Propagators] with the named propagators, resolved via [Propagators],
+	// e.g. "tracecontext", "baggage" and "b3". Defaults to the comma-separated OTEL_PROPAGATORS environment variable, if set
+	// and [Settings.Propagators] is unchanged from its default. Unknown names are skipped; if none are known,
+	// [Settings.Propagators] applies.
+	PropagatorNames []string
+
+	// Inherit, if true, captures the trace context and baggage a parent process passed via environment variables (see
+	// [Command]) at startup, for continuing the parent's trace via [Inherited]. Default is false.
+	Inherit bool
+
+	// Grace bounds the shutdown performed by [Fatal] and [Exit] before the process exits. Defaults to 30 seconds.
+	Grace time.Duration
+
+	// pipeline is the state of the providers created by [Setup].
+	pipeline *pipeline
 }
 
 type Variadic func(options *Settings)
@@ -113,25 +189,23 @@ func Options() *Settings {
 		},
 		Metrics: &Metrics{
 			Options: []otlpmetrichttp.Option{
-				otlpmetrichttp.WithInsecure(),
-				otlpmetrichttp.WithEndpoint("opentelemetry-collector.observability.svc.cluster.local:4318"),
+				insecureMetrics{otlpmetrichttp.WithInsecure()},
+				otlpmetrichttp.WithEndpoint(endpoint),
 			},
 		},
 		Tracer: &Tracer{
 			Options: []otlptracehttp.Option{
-				otlptracehttp.WithInsecure(),
-				otlptracehttp.WithEndpoint("opentelemetry-collector.observability.svc.cluster.local:4318"),
+				insecureTraces{otlptracehttp.WithInsecure()},
+				otlptracehttp.WithEndpoint(endpoint),
 			},
 		},
 		Logs: &Logs{
 			Options: []otlploghttp.Option{
-				otlploghttp.WithInsecure(),
-				otlploghttp.WithEndpoint("opentelemetry-collector.observability.svc.cluster.local:4318"),
+				insecureLogs{otlploghttp.WithInsecure()},
+				otlploghttp.WithEndpoint(endpoint),
 			},
 		},
-		Propagators: []propagation.TextMapPropagator{
-			propagation.TraceContext{},
-			propagation.Baggage{},
-		},
+		Propagators: standard(),
+		Grace:       30 * time.Second,
 	}
 }
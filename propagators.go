@@ -0,0 +1,99 @@
+package telemetry
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/contrib/propagators/aws/xray"
+	"go.opentelemetry.io/contrib/propagators/b3"
+	"go.opentelemetry.io/contrib/propagators/jaeger"
+	"go.opentelemetry.io/contrib/propagators/ot"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// registry maps propagator names, as used by the OTEL_PROPAGATORS environment variable, to their implementation.
+var registry = struct {
+	mutex       sync.RWMutex
+	propagators map[string]propagation.TextMapPropagator
+}{
+	propagators: map[string]propagation.TextMapPropagator{
+		"tracecontext": propagation.TraceContext{},
+		"baggage":      propagation.Baggage{},
+		"b3":           b3.New(b3.WithInjectEncoding(b3.B3SingleHeader)),
+		"b3multi":      b3.New(b3.WithInjectEncoding(b3.B3MultipleHeader)),
+		"jaeger":       jaeger.Jaeger{},
+		"xray":         xray.Propagator{},
+		"ottrace":      ot.OT{},
+		"none":         propagation.NewCompositeTextMapPropagator(),
+	},
+}
+
+// RegisterPropagator adds, or replaces, a named propagator resolvable by [Propagators], [Settings.PropagatorNames] and the
+// OTEL_PROPAGATORS environment variable. Names are case-insensitive.
+//
+// Built-in names are "tracecontext", "baggage", "b3" (single header), "b3multi", "jaeger", "xray", "ottrace" and "none".
+func RegisterPropagator(name string, propagator propagation.TextMapPropagator) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	registry.propagators[strings.ToLower(strings.TrimSpace(name))] = propagator
+}
+
+// Propagators resolves names into propagators, in order. Names that are not registered are skipped and reported by the
+// returned error; see [RegisterPropagator].
+func Propagators(names ...string) ([]propagation.TextMapPropagator, error) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	var e error
+
+	propagators := make([]propagation.TextMapPropagator, 0, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		p, found := registry.propagators[name]
+		if !(found) {
+			e = errors.Join(e, fmt.Errorf("unknown propagator: %q", name))
+			continue
+		}
+
+		propagators = append(propagators, p)
+	}
+
+	return propagators, e
+}
+
+// standard returns the default [Settings.Propagators].
+func standard() []propagation.TextMapPropagator {
+	return []propagation.TextMapPropagator{
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	}
+}
+
+// names returns the propagator names configured by [Settings.PropagatorNames], or else the OTEL_PROPAGATORS environment
+// variable, unless [Settings.Propagators] was changed from its default.
+func names(settings *Settings) []string {
+	if len(settings.PropagatorNames) > 0 {
+		return settings.PropagatorNames
+	}
+
+	// Propagators set in code take precedence over the environment. The defaults' types are comparable, so comparing
+	// them with any other propagator can't panic.
+	if !(slices.Equal(settings.Propagators, standard())) {
+		return nil
+	}
+
+	if value := os.Getenv("OTEL_PROPAGATORS"); value != "" {
+		return strings.Split(value, ",")
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package telemetry_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+func TestPropagators(t *testing.T) {
+	// inject returns the headers written by the global propagator for a span started after [telemetry.Setup].
+	inject := func(t *testing.T, settings telemetry.Variadic) http.Header {
+		t.Helper()
+
+		ctx := context.Background()
+
+		shutdown := local(t, io.Discard, io.Discard, settings)
+
+		defer shutdown(ctx)
+
+		ctx, span := otel.Tracer("github.com/application").Start(ctx, "Propagated Span")
+		defer span.End()
+
+		headers := http.Header{}
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(headers))
+
+		return headers
+	}
+
+	t.Run("Telemetry-Named-Propagators", func(t *testing.T) {
+		headers := inject(t, func(options *telemetry.Settings) {
+			options.PropagatorNames = []string{"b3multi", "Jaeger", "ottrace"}
+		})
+
+		for _, header := range []string{"X-B3-Traceid", "Uber-Trace-Id", "Ot-Tracer-Traceid"} {
+			if headers.Get(header) == "" {
+				t.Errorf("Missing Header %s: %v", header, headers)
+			}
+		}
+
+		if headers.Get("Traceparent") != "" {
+			t.Errorf("Unexpected Header Traceparent: %v", headers)
+		}
+	})
+
+	t.Run("Telemetry-Environment-Propagators", func(t *testing.T) {
+		t.Setenv("OTEL_PROPAGATORS", "tracecontext, b3, unknown")
+
+		headers := inject(t, func(options *telemetry.Settings) {})
+
+		for _, header := range []string{"Traceparent", "B3"} {
+			if headers.Get(header) == "" {
+				t.Errorf("Missing Header %s: %v", header, headers)
+			}
+		}
+	})
+
+	t.Run("Telemetry-Explicit-Propagators-Precede-Environment", func(t *testing.T) {
+		t.Setenv("OTEL_PROPAGATORS", "b3")
+
+		headers := inject(t, func(options *telemetry.Settings) {
+			options.Propagators = []propagation.TextMapPropagator{propagation.TraceContext{}}
+		})
+
+		if headers.Get("Traceparent") == "" {
+			t.Errorf("Missing Header Traceparent: %v", headers)
+		}
+
+		if headers.Get("B3") != "" {
+			t.Errorf("Unexpected Header B3: %v", headers)
+		}
+	})
+
+	t.Run("Telemetry-Unknown-Propagators-Fallback", func(t *testing.T) {
+		headers := inject(t, func(options *telemetry.Settings) {
+			options.PropagatorNames = []string{"unknown"}
+		})
+
+		if headers.Get("Traceparent") == "" {
+			t.Errorf("Missing Header Traceparent: %v", headers)
+		}
+	})
+
+	t.Run("Telemetry-Registered-Propagator", func(t *testing.T) {
+		telemetry.RegisterPropagator("custom", propagation.TraceContext{})
+
+		propagators, e := telemetry.Propagators("custom", "xray", "missing")
+		if e == nil {
+			t.Error("Expected Error for Unknown Propagator")
+		}
+
+		if len(propagators) != 2 {
+			t.Errorf("Unexpected Number of Propagators: %d", len(propagators))
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// deadline bounds the flush performed before a crashing process exits.
+const deadline = 2 * time.Second
+
+// Recover records a panic before letting it continue: the panic is added as an exception event to the context's active
+// span, which is marked as failed and ended; an error log including the stack trace is emitted; and the pipeline is
+// flushed within a short deadline. Afterward the panic is re-raised.
+//
+// Recover must be deferred directly, immediately after starting the span to be failed, with the span's context; deferred
+// after the span's End, it runs first, while the span is still recording:
+//
+//	ctx, span := tracer.Start(ctx, "operation")
+//	defer span.End()
+//	defer telemetry.Recover(ctx)
+func Recover(ctx context.Context) {
+	if r := recover(); r != nil {
+		crash(ctx, r, debug.Stack())
+
+		panic(r)
+	}
+}
+
+// Go runs fn in a new goroutine guarded by [Recover].
+func Go(ctx context.Context, fn func(ctx context.Context)) {
+	go func() {
+		defer Recover(ctx)
+
+		fn(ctx)
+	}()
+}
+
+// crash records the recovered panic value on the active span and logs, then flushes the pipeline.
+func crash(ctx context.Context, r any, stack []byte) {
+	e, ok := r.(error)
+	if !(ok) {
+		e = fmt.Errorf("%v", r)
+	}
+
+	message := fmt.Sprintf("panic: %v", e)
+	kind := fmt.Sprintf("%T", r)
+
+	span := trace.SpanFromContext(ctx)
+	if span.IsRecording() {
+		span.AddEvent(semconv.ExceptionEventName, trace.WithAttributes(
+			semconv.ExceptionType(kind),
+			semconv.ExceptionMessage(e.Error()),
+			semconv.ExceptionStacktrace(string(stack)),
+			semconv.ExceptionEscaped(true),
+		))
+
+		span.SetStatus(codes.Error, message)
+
+		// End the span before flushing, so that it's exported; the caller's deferred End only runs after Recover returns,
+		// during the re-raised panic's unwinding, and is then a no-op.
+		span.End()
+	}
+
+	slog.ErrorContext(ctx, "Unrecovered Panic", slog.String("error", e.Error()), slog.String("error-type", kind), slog.String("stack", string(stack)))
+
+	// The default slog handler only reaches the logger provider if the bridge is installed.
+	if p := active.Load(); p == nil || p.settings.Logs.Bridge == nil {
+		emit(ctx, otellog.SeverityError, message, attribute.String(string(semconv.ExceptionTypeKey), kind), semconv.ExceptionStacktrace(string(stack)))
+	}
+
+	flush, cancel := context.WithTimeout(context.WithoutCancel(ctx), deadline)
+	defer cancel()
+
+	if e := Flush(flush); e != nil {
+		slog.ErrorContext(ctx, "Exception During Telemetry Pipeline Flush", slog.String("error", e.Error()))
+	}
+}
+
+// emit writes a record directly to the global logger provider.
+func emit(ctx context.Context, severity otellog.Severity, message string, attributes ...attribute.KeyValue) {
+	var record otellog.Record
+
+	record.SetTimestamp(time.Now())
+	record.SetSeverity(severity)
+	record.SetSeverityText(severity.String())
+	record.SetBody(otellog.StringValue(message))
+
+	for _, kv := range attributes {
+		record.AddAttributes(otellog.String(string(kv.Key), kv.Value.Emit()))
+	}
+
+	global.GetLoggerProvider().Logger("github.com/poly-gun/go-telemetry").Emit(ctx, record)
+}
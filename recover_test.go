@@ -0,0 +1,55 @@
+package telemetry_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+func TestRecover(t *testing.T) {
+	t.Run("Telemetry-Panic-Capture", func(t *testing.T) {
+		ctx := context.Background()
+
+		var traces, logs bytes.Buffer
+
+		shutdown := local(t, &traces, &logs)
+
+		defer shutdown(ctx)
+
+		failure := errors.New("boom")
+
+		recovered := func() (r any) {
+			defer func() {
+				r = recover()
+			}()
+
+			ctx, span := otel.Tracer("github.com/application").Start(ctx, "Crashing Span")
+			defer span.End()
+
+			defer telemetry.Recover(ctx)
+
+			panic(failure)
+		}()
+
+		if recovered != failure {
+			t.Fatalf("Expected Panic to Be Re-Raised, Recovered: %v", recovered)
+		}
+
+		// The flush happened before the panic was re-raised; no sleep or flush is needed here.
+		for _, expected := range []string{"Crashing Span", `"Name": "exception"`, "exception.stacktrace", "panic: boom", `"Code": "Error"`} {
+			if !(strings.Contains(traces.String(), expected)) {
+				t.Errorf("Traces Missing %s:\n%s", expected, traces.String())
+			}
+		}
+
+		if !(strings.Contains(logs.String(), "panic: boom")) || !(strings.Contains(logs.String(), "ERROR")) {
+			t.Errorf("Logs Missing Panic Record:\n%s", logs.String())
+		}
+	})
+}
@@ -0,0 +1,473 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	otellog "go.opentelemetry.io/otel/log"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Detector identifies a category of sensitive data within string values.
+type Detector struct {
+	// Name describes the detected data, e.g. "email".
+	Name string
+
+	// Pattern matches candidate substrings.
+	Pattern *regexp.Regexp
+
+	// Validate optionally confirms a candidate match, reducing false positives. Defaults nil, accepting every match.
+	Validate func(match string) bool
+}
+
+var (
+	// Email detects email addresses.
+	Email = Detector{Name: "email", Pattern: regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)}
+
+	// CreditCard detects payment card numbers of 13 to 19 digits, optionally separated by spaces or dashes, that pass the Luhn checksum.
+	CreditCard = Detector{Name: "credit-card", Pattern: regexp.MustCompile(`\b\d(?:[ \-]?\d){12,18}\b`), Validate: luhn}
+
+	// BearerToken detects "Bearer" authorization credentials.
+	BearerToken = Detector{Name: "bearer-token", Pattern: regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9\-._~+/]+=*`)}
+
+	// JWT detects JSON web tokens.
+	JWT = Detector{Name: "jwt", Pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+`)}
+
+	// AWSAccessKey detects AWS access key identifiers.
+	AWSAccessKey = Detector{Name: "aws-access-key", Pattern: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)}
+)
+
+// Detectors returns all built-in [Detector] values.
+func Detectors() []Detector {
+	return []Detector{Email, CreditCard, BearerToken, JWT, AWSAccessKey}
+}
+
+// luhn validates the checksum of a payment card number, ignoring separators.
+func luhn(match string) bool {
+	var sum, count int
+	double := false
+	for i := len(match) - 1; i >= 0; i-- {
+		c := match[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+
+		digit := int(c - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+		count++
+		double = !(double)
+	}
+
+	return count >= 13 && sum%10 == 0
+}
+
+// Redactor scrubs sensitive data from spans, log records and metric attributes exported by the pipeline built by [Setup].
+//
+// Span and log attribute values are replaced by [Redactor.Replacement], as are sensitive substrings of span names, event
+// names and status descriptions. Metric attributes cannot be rewritten, since they identify time-series, and are therefore
+// dropped instead.
+type Redactor struct {
+	// Keys are case-insensitive glob patterns (see [SeverityFilter] for the syntax) matched against attribute keys. The entire
+	// value of matching attributes is redacted, e.g. "*password*", "*secret*" or "authorization".
+	Keys []string
+
+	// Values are regular expressions whose matches within string values are redacted.
+	Values []*regexp.Regexp
+
+	// Detectors identify common sensitive data within string values; see [Detectors] for the built-in set.
+	Detectors []Detector
+
+	// Replacement is substituted for redacted data. Defaults to "[REDACTED]".
+	Replacement string
+
+	// counts of redactions performed, indexed by signal.
+	counts [3]atomic.Int64
+}
+
+const (
+	spans = iota
+	records
+	measurements
+)
+
+// Redactions returns the total number of redactions performed across all signals.
+func (r *Redactor) Redactions() int64 {
+	return r.counts[spans].Load() + r.counts[records].Load() + r.counts[measurements].Load()
+}
+
+func (r *Redactor) replacement() string {
+	if r.Replacement == "" {
+		return "[REDACTED]"
+	}
+
+	return r.Replacement
+}
+
+// key reports whether the attribute key is sensitive.
+func (r *Redactor) key(key string) bool {
+	key = strings.ToLower(key)
+	for _, pattern := range r.Keys {
+		if glob(strings.ToLower(pattern), key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String redacts sensitive substrings of value, reporting whether any were found.
+func (r *Redactor) String(value string) (string, bool) {
+	redacted := false
+	replacement := r.replacement()
+
+	for _, expression := range r.Values {
+		if expression.MatchString(value) {
+			value = expression.ReplaceAllLiteralString(value, replacement)
+			redacted = true
+		}
+	}
+
+	for _, detector := range r.Detectors {
+		value = detector.Pattern.ReplaceAllStringFunc(value, func(match string) string {
+			if detector.Validate != nil && !(detector.Validate(match)) {
+				return match
+			}
+
+			redacted = true
+
+			return replacement
+		})
+	}
+
+	return value, redacted
+}
+
+// attribute redacts a single attribute, reporting whether it was modified.
+func (r *Redactor) attribute(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+	if r.key(string(kv.Key)) {
+		return kv.Key.String(r.replacement()), true
+	}
+
+	switch kv.Value.Type() {
+	case attribute.STRING:
+		if value, redacted := r.String(kv.Value.AsString()); redacted {
+			return kv.Key.String(value), true
+		}
+	case attribute.STRINGSLICE:
+		values := kv.Value.AsStringSlice()
+
+		modified := false
+		for i := range values {
+			if value, redacted := r.String(values[i]); redacted {
+				values[i], modified = value, true
+			}
+		}
+
+		if modified {
+			return kv.Key.StringSlice(values), true
+		}
+	}
+
+	return kv, false
+}
+
+// attributes redacts a list of attributes, returning the number modified. The list is copied only if modified.
+func (r *Redactor) attributes(list []attribute.KeyValue) ([]attribute.KeyValue, int) {
+	var count int
+	var copied []attribute.KeyValue
+
+	for i, kv := range list {
+		if redacted, modified := r.attribute(kv); modified {
+			if copied == nil {
+				copied = append([]attribute.KeyValue(nil), list...)
+			}
+
+			copied[i] = redacted
+			count++
+		}
+	}
+
+	if copied == nil {
+		return list, 0
+	}
+
+	return copied, count
+}
+
+// value redacts a log value, recursing into maps and slices, returning the number of redactions.
+func (r *Redactor) value(v otellog.Value) (otellog.Value, int) {
+	switch v.Kind() {
+	case otellog.KindString:
+		if value, redacted := r.String(v.AsString()); redacted {
+			return otellog.StringValue(value), 1
+		}
+	case otellog.KindSlice:
+		var count int
+
+		values := v.AsSlice()
+		copied := make([]otellog.Value, len(values))
+		for i, element := range values {
+			var n int
+			copied[i], n = r.value(element)
+			count += n
+		}
+
+		if count > 0 {
+			return otellog.SliceValue(copied...), count
+		}
+	case otellog.KindMap:
+		var count int
+
+		values := v.AsMap()
+		copied := make([]otellog.KeyValue, len(values))
+		for i, kv := range values {
+			var n int
+			copied[i], n = r.keyValue(kv)
+			count += n
+		}
+
+		if count > 0 {
+			return otellog.MapValue(copied...), count
+		}
+	}
+
+	return v, 0
+}
+
+func (r *Redactor) keyValue(kv otellog.KeyValue) (otellog.KeyValue, int) {
+	if r.key(kv.Key) {
+		return otellog.String(kv.Key, r.replacement()), 1
+	}
+
+	value, count := r.value(kv.Value)
+
+	return otellog.KeyValue{Key: kv.Key, Value: value}, count
+}
+
+// redactedSpan is a [trace.ReadOnlySpan] overriding the name, attributes, events, links and status of the span it wraps.
+type redactedSpan struct {
+	trace.ReadOnlySpan
+
+	name       string
+	attributes []attribute.KeyValue
+	events     []trace.Event
+	links      []trace.Link
+	status     trace.Status
+}
+
+func (s *redactedSpan) Name() string {
+	return s.name
+}
+
+func (s *redactedSpan) Attributes() []attribute.KeyValue {
+	return s.attributes
+}
+
+func (s *redactedSpan) Events() []trace.Event {
+	return s.events
+}
+
+func (s *redactedSpan) Links() []trace.Link {
+	return s.links
+}
+
+func (s *redactedSpan) Status() trace.Status {
+	return s.status
+}
+
+// span returns a redacted copy of s, or s itself if nothing required redaction. The span, shared with every other
+// processor, is never modified.
+func (r *Redactor) span(s trace.ReadOnlySpan) trace.ReadOnlySpan {
+	redacted := &redactedSpan{ReadOnlySpan: s, name: s.Name(), events: slices.Clone(s.Events()), links: slices.Clone(s.Links()), status: s.Status()}
+
+	var count, n int
+
+	if name, ok := r.String(redacted.name); ok {
+		redacted.name = name
+		count++
+	}
+
+	redacted.attributes, n = r.attributes(s.Attributes())
+	count += n
+
+	for i := range redacted.events {
+		if name, ok := r.String(redacted.events[i].Name); ok {
+			redacted.events[i].Name = name
+			count++
+		}
+
+		redacted.events[i].Attributes, n = r.attributes(redacted.events[i].Attributes)
+		count += n
+	}
+
+	for i := range redacted.links {
+		redacted.links[i].Attributes, n = r.attributes(redacted.links[i].Attributes)
+		count += n
+	}
+
+	if description, ok := r.String(redacted.status.Description); ok {
+		redacted.status.Description = description
+		count++
+	}
+
+	if count == 0 {
+		return s
+	}
+
+	r.counts[spans].Add(int64(count))
+
+	return redacted
+}
+
+// redactedSpans is a [trace.SpanProcessor] forwarding redacted copies of ended spans to the next processors. A single
+// instance fronts every exporter, so that each span is redacted, and its redactions counted, once.
+type redactedSpans struct {
+	redactor *Redactor
+	next     []trace.SpanProcessor
+}
+
+func (p *redactedSpans) OnStart(ctx context.Context, s trace.ReadWriteSpan) {
+	for _, next := range p.next {
+		next.OnStart(ctx, s)
+	}
+}
+
+func (p *redactedSpans) OnEnd(s trace.ReadOnlySpan) {
+	redacted := p.redactor.span(s)
+	for _, next := range p.next {
+		next.OnEnd(redacted)
+	}
+}
+
+func (p *redactedSpans) Shutdown(ctx context.Context) error {
+	var e error
+	for _, next := range p.next {
+		e = errors.Join(e, next.Shutdown(ctx))
+	}
+
+	return e
+}
+
+func (p *redactedSpans) ForceFlush(ctx context.Context) error {
+	var e error
+	for _, next := range p.next {
+		e = errors.Join(e, next.ForceFlush(ctx))
+	}
+
+	return e
+}
+
+// redactedRecords is a [log.Processor] redacting records in place before forwarding them to the next processor.
+type redactedRecords struct {
+	redactor *Redactor
+	next     log.Processor
+}
+
+func (p *redactedRecords) OnEmit(ctx context.Context, record *log.Record) error {
+	var count int
+
+	body, n := p.redactor.value(record.Body())
+	if n > 0 {
+		record.SetBody(body)
+		count += n
+	}
+
+	attributes := make([]otellog.KeyValue, 0, record.AttributesLen())
+	modified := false
+	record.WalkAttributes(func(kv otellog.KeyValue) bool {
+		redacted, n := p.redactor.keyValue(kv)
+		if n > 0 {
+			modified = true
+			count += n
+		}
+
+		attributes = append(attributes, redacted)
+
+		return true
+	})
+
+	if modified {
+		record.SetAttributes(attributes...)
+	}
+
+	p.redactor.counts[records].Add(int64(count))
+
+	return p.next.OnEmit(ctx, record)
+}
+
+func (p *redactedRecords) Enabled(ctx context.Context, parameters log.EnabledParameters) bool {
+	if next, ok := p.next.(log.FilterProcessor); ok {
+		return next.Enabled(ctx, parameters)
+	}
+
+	return true
+}
+
+func (p *redactedRecords) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+func (p *redactedRecords) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+// view returns a [metric.View] dropping sensitive attributes from every instrument's measurements.
+func (r *Redactor) view() metric.View {
+	return metric.NewView(metric.Instrument{Name: "*"}, metric.Stream{
+		AttributeFilter: func(kv attribute.KeyValue) bool {
+			if _, redacted := r.attribute(kv); redacted {
+				r.counts[measurements].Add(1)
+				return false
+			}
+
+			return true
+		},
+	})
+}
+
+// instrument registers the "telemetry.redactions" counter reporting redactions performed per signal.
+func (r *Redactor) instrument(provider otelmetric.MeterProvider) error {
+	_, e := provider.Meter("github.com/poly-gun/go-telemetry").Int64ObservableCounter("telemetry.redactions",
+		otelmetric.WithDescription("Number of sensitive values redacted from exported telemetry."),
+		otelmetric.WithUnit("{redaction}"),
+		otelmetric.WithInt64Callback(func(ctx context.Context, observer otelmetric.Int64Observer) error {
+			observer.Observe(r.counts[spans].Load(), otelmetric.WithAttributes(attribute.String("signal", "traces")))
+			observer.Observe(r.counts[records].Load(), otelmetric.WithAttributes(attribute.String("signal", "logs")))
+			observer.Observe(r.counts[measurements].Load(), otelmetric.WithAttributes(attribute.String("signal", "metrics")))
+
+			return nil
+		}),
+	)
+
+	return e
+}
+
+// processors returns the options registering the exporters' span processors, fronted by a single redacting processor if
+// a [Settings.Redactor] is configured.
+func processors(settings *Settings, processors ...trace.SpanProcessor) []trace.TracerProviderOption {
+	if settings.Redactor != nil {
+		return []trace.TracerProviderOption{trace.WithSpanProcessor(&redactedSpans{redactor: settings.Redactor, next: processors})}
+	}
+
+	options := make([]trace.TracerProviderOption, 0, len(processors))
+	for _, p := range processors {
+		options = append(options, trace.WithSpanProcessor(p))
+	}
+
+	return options
+}
@@ -0,0 +1,127 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Outcome is the result of shutting down one component of the pipeline.
+type Outcome struct {
+	// Component is the name of the component, e.g. "traces", "metrics", "logs", "bridge" or "transport".
+	Component string
+
+	// Duration is the time the component took to shut down.
+	Duration time.Duration
+
+	// Error is the error returned by the component's shutdown, if any.
+	Error error
+}
+
+// ShutdownError is returned by the shutdown function of [Setup] when any component fails to shut down. It lists the
+// outcome of every component, including those that succeeded, and unwraps to the individual errors.
+type ShutdownError struct {
+	Outcomes []Outcome
+}
+
+func (e *ShutdownError) Error() string {
+	var failures []string
+	for _, outcome := range e.Outcomes {
+		if outcome.Error != nil {
+			failures = append(failures, fmt.Sprintf("%s (%s): %v", outcome.Component, outcome.Duration.Round(time.Millisecond), outcome.Error))
+		}
+	}
+
+	return fmt.Sprintf("telemetry shutdown failed: %s", strings.Join(failures, "; "))
+}
+
+// Unwrap returns the errors of the failed components.
+func (e *ShutdownError) Unwrap() []error {
+	var failures []error
+	for _, outcome := range e.Outcomes {
+		if outcome.Error != nil {
+			failures = append(failures, outcome.Error)
+		}
+	}
+
+	return failures
+}
+
+// Failed returns the outcome of the named component, and whether it failed.
+func (e *ShutdownError) Failed(component string) (Outcome, bool) {
+	for _, outcome := range e.Outcomes {
+		if outcome.Component == component {
+			return outcome, outcome.Error != nil
+		}
+	}
+
+	return Outcome{}, false
+}
+
+// component is a named shutdown function with an optional time budget.
+type component struct {
+	name     string
+	budget   time.Duration
+	shutdown func(context.Context) error
+}
+
+// stages runs each stage's components sequentially, and each component within a stage in parallel, each bounded by its
+// own budget. A summary of the outcomes is logged; the returned error is a [*ShutdownError] if any component failed.
+func stages(ctx context.Context, stages ...[]component) error {
+	var outcomes []Outcome
+
+	for _, stage := range stages {
+		results := make([]Outcome, len(stage))
+
+		var wg sync.WaitGroup
+		for i, c := range stage {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				ctx := ctx
+				if c.budget > 0 {
+					var cancel context.CancelFunc
+
+					ctx, cancel = context.WithTimeout(ctx, c.budget)
+					defer cancel()
+				}
+
+				start := time.Now()
+				e := c.shutdown(ctx)
+
+				results[i] = Outcome{Component: c.name, Duration: time.Since(start), Error: e}
+			}()
+		}
+
+		wg.Wait()
+
+		outcomes = append(outcomes, results...)
+	}
+
+	failed := false
+
+	attributes := make([]any, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		group := []any{slog.Duration("duration", outcome.Duration)}
+		if outcome.Error != nil {
+			group = append(group, slog.String("error", outcome.Error.Error()))
+			failed = true
+		}
+
+		attributes = append(attributes, slog.Group(outcome.Component, group...))
+	}
+
+	if failed {
+		slog.WarnContext(ctx, "Telemetry Pipeline Shutdown Summary", attributes...)
+
+		return &ShutdownError{Outcomes: outcomes}
+	}
+
+	slog.DebugContext(ctx, "Telemetry Pipeline Shutdown Summary", attributes...)
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package telemetry_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+func TestShutdown(t *testing.T) {
+	t.Run("Telemetry-Concurrent-Shutdown", func(t *testing.T) {
+		ctx := context.Background()
+
+		shutdown := local(t, io.Discard, io.Discard)
+
+		// E.g. [telemetry.Exit] racing an interrupt; each provider must only be shut down once.
+		var group sync.WaitGroup
+
+		errs := make([]error, 8)
+		for index := range errs {
+			group.Add(1)
+			go func() {
+				defer group.Done()
+
+				errs[index] = shutdown(ctx)
+			}()
+		}
+
+		group.Wait()
+
+		if e := errors.Join(errs...); e != nil {
+			t.Errorf("Unexpected Error During Concurrent Shutdown: %v", e)
+		}
+	})
+
+	t.Run("Telemetry-Parallel-Shutdown-Budgets", func(t *testing.T) {
+		ctx := context.Background()
+
+		release := make(chan struct{})
+
+		// The collector never responds, simulating a hanging trace exporter.
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-release:
+			}
+		}))
+
+		defer server.Close()
+		defer close(release)
+
+		shutdown := local(t, io.Discard, io.Discard, func(options *telemetry.Settings) {
+			options.Tracer.Local = false
+			options.Tracer.Options = []otlptracehttp.Option{otlptracehttp.WithEndpointURL(server.URL + "/v1/traces")}
+			options.Tracer.Budget = 250 * time.Millisecond
+		})
+
+		_, span := otel.Tracer("github.com/application").Start(ctx, "Pending Span")
+		span.End()
+
+		start := time.Now()
+
+		e := shutdown(ctx)
+
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("Shutdown Exceeded Budget: %s", elapsed)
+		}
+
+		var failure *telemetry.ShutdownError
+		if !(errors.As(e, &failure)) {
+			t.Fatalf("Expected ShutdownError, Received: %v", e)
+		}
+
+		if outcome, failed := failure.Failed("traces"); !(failed) || !(errors.Is(outcome.Error, context.DeadlineExceeded)) {
+			t.Errorf("Unexpected Traces Outcome: %+v", outcome)
+		}
+
+		for _, component := range []string{"metrics", "logs", "bridge", "transport"} {
+			if outcome, failed := failure.Failed(component); failed || outcome.Component != component {
+				t.Errorf("Unexpected %s Outcome: %+v", component, outcome)
+			}
+		}
+
+		if e := shutdown(ctx); e != nil {
+			t.Errorf("Unexpected Error During Repeated Shutdown: %v", e)
+		}
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"os"
 	"reflect"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -15,6 +16,7 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
 	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
 	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
@@ -94,8 +96,21 @@ func resources(ctx context.Context) *resource.Resource {
 	return instance
 }
 
-func propagator(settings *Settings) {
-	provider := propagation.NewCompositeTextMapPropagator(settings.Propagators...)
+func propagator(ctx context.Context, settings *Settings) {
+	propagators := settings.Propagators
+	if configured := names(settings); len(configured) > 0 {
+		named, e := Propagators(configured...)
+		if e != nil {
+			slog.WarnContext(ctx, "Non-Fatal Open-Telemetry Error", slog.String("error", e.Error()))
+		}
+
+		// Unknown names mustn't silently disable propagation.
+		if len(named) > 0 {
+			propagators = named
+		}
+	}
+
+	provider := propagation.NewCompositeTextMapPropagator(propagators...)
 
 	// Register the global propagation provider.
 	otel.SetTextMapPropagator(provider)
@@ -104,11 +119,19 @@ func propagator(settings *Settings) {
 }
 
 func traces(ctx context.Context, settings *Settings) *trace.TracerProvider {
+	settings.pipeline.resource = resources(ctx)
+
 	options := []trace.TracerProviderOption{
-		trace.WithResource(resources(ctx)),
+		trace.WithResource(settings.pipeline.resource),
 		trace.WithSampler(trace.AlwaysSample()),
 	}
 
+	if settings.Baggage != nil {
+		options = append(options, trace.WithSpanProcessor(&baggaged{settings: settings.Baggage}))
+	}
+
+	var batchers []trace.SpanProcessor
+
 	if settings.Tracer.Local && settings.Tracer.Debugger == nil {
 		var e error
 
@@ -125,29 +148,31 @@ func traces(ctx context.Context, settings *Settings) *trace.TracerProvider {
 
 		exporter := settings.Tracer.Debugger
 
-		options = append(options, trace.WithBatcher(exporter, trace.WithBatchTimeout(time.Second*5)))
+		batchers = append(batchers, batcher(settings, exporter, time.Second*5))
 	} else if settings.Tracer.Debugger != nil {
 		exporter := settings.Tracer.Debugger
 
-		options = append(options, trace.WithBatcher(exporter, trace.WithBatchTimeout(time.Second*5)))
+		batchers = append(batchers, batcher(settings, exporter, time.Second*5))
 	} else {
 		exporter, e := otlptracehttp.New(ctx, settings.Tracer.Options...)
 		if e != nil {
 			panic(e)
 		}
 
-		options = append(options, trace.WithBatcher(exporter, trace.WithBatchTimeout(time.Second*30)))
+		batchers = append(batchers, batcher(settings, exporter, time.Second*30))
 
 		if settings.Zipkin.Enabled {
-			z, e := zipkin.New(settings.Zipkin.URL)
+			z, e := zipkin.New(settings.Zipkin.URL, settings.Zipkin.Options...)
 			if e != nil {
 				panic(e)
 			}
 
-			options = append(options, trace.WithBatcher(z, trace.WithBatchTimeout(time.Second*30)))
+			batchers = append(batchers, batcher(settings, z, time.Second*30))
 		}
 	}
 
+	options = append(options, processors(settings, batchers...)...)
+
 	provider := trace.NewTracerProvider(options...)
 
 	// Register the global tracer provider.
@@ -169,6 +194,24 @@ func metrics(ctx context.Context, settings *Settings) *metric.MeterProvider {
 
 	options := make([]metric.Option, 0)
 
+	if settings.Redactor != nil {
+		options = append(options, metric.WithView(settings.Redactor.view()))
+	}
+
+	if settings.Metrics.Exemplars != nil {
+		options = append(options, metric.WithExemplarFilter(settings.Metrics.Exemplars))
+	}
+
+	if settings.Metrics.Prometheus != nil {
+		exporter, e := prometheus.New(prometheus.WithRegisterer(settings.Metrics.Prometheus))
+		if e != nil {
+			e = fmt.Errorf("unable to instantiate prometheus metrics exporter: %w", e)
+			panic(e)
+		}
+
+		options = append(options, metric.WithReader(exporter))
+	}
+
 	if settings.Metrics.Local && settings.Metrics.Debugger == nil {
 		var e error
 
@@ -185,11 +228,11 @@ func metrics(ctx context.Context, settings *Settings) *metric.MeterProvider {
 
 		exporter := settings.Metrics.Debugger
 
-		options = append(options, metric.WithReader(metric.NewPeriodicReader(exporter, metric.WithInterval(5*time.Second))))
+		options = append(options, metric.WithReader(reader(settings, exporter, 5*time.Second)))
 	} else if settings.Metrics.Debugger != nil {
 		exporter := settings.Metrics.Debugger
 
-		options = append(options, metric.WithReader(metric.NewPeriodicReader(exporter, metric.WithInterval(5*time.Second))))
+		options = append(options, metric.WithReader(reader(settings, exporter, 5*time.Second)))
 	} else {
 		exporter, e := otlpmetrichttp.New(ctx, settings.Metrics.Options...)
 		if e != nil {
@@ -197,7 +240,7 @@ func metrics(ctx context.Context, settings *Settings) *metric.MeterProvider {
 			panic(e)
 		}
 
-		options = append(options, metric.WithReader(metric.NewPeriodicReader(exporter, metric.WithInterval(30*time.Second))))
+		options = append(options, metric.WithReader(reader(settings, exporter, 30*time.Second)))
 	}
 
 	provider := metric.NewMeterProvider(options...)
@@ -227,11 +270,11 @@ func logexporter(ctx context.Context, settings *Settings) *log.LoggerProvider {
 
 		exporter := settings.Logs.Debugger
 
-		options = append(options, log.WithProcessor(log.NewSimpleProcessor(exporter)))
+		options = append(options, log.WithProcessor(logprocessor(settings, exporter, false)))
 	} else if settings.Logs.Debugger != nil {
 		exporter := settings.Logs.Debugger
 
-		options = append(options, log.WithProcessor(log.NewSimpleProcessor(exporter)))
+		options = append(options, log.WithProcessor(logprocessor(settings, exporter, false)))
 	} else {
 		exporter, e := otlploghttp.New(ctx, settings.Logs.Options...)
 		if e != nil {
@@ -239,7 +282,7 @@ func logexporter(ctx context.Context, settings *Settings) *log.LoggerProvider {
 			panic(e)
 		}
 
-		options = append(options, log.WithProcessor(log.NewBatchProcessor(exporter)))
+		options = append(options, log.WithProcessor(logprocessor(settings, exporter, true)))
 	}
 
 	provider := log.NewLoggerProvider(options...)
@@ -250,7 +293,7 @@ func logexporter(ctx context.Context, settings *Settings) *log.LoggerProvider {
 	return provider
 }
 
-// Setup bootstraps the OpenTelemetry pipeline.
+// Setup bootstraps the OpenTelemetry pipeline. Buffered telemetry can be exported without shutting the pipeline down via [Flush].
 func Setup(ctx context.Context, options ...Variadic) (shutdown func(context.Context) error) {
 	slog.DebugContext(ctx, "Starting the Telemetry Pipeline ...")
 
@@ -259,32 +302,79 @@ func Setup(ctx context.Context, options ...Variadic) (shutdown func(context.Cont
 		option(o)
 	}
 
-	var shutdowns []func(context.Context) error
+	o.pipeline = &pipeline{settings: o}
+
+	var shutdowns [][]component
+
+	// mutex serializes shutdown, which [Exit] and the interrupt handlers may call concurrently.
+	var mutex sync.Mutex
 
-	// shutdown calls cleanup functions registered via shutdownFuncs.
-	// The errors from the calls are joined.
-	// Each registered cleanup will be invoked once.
+	// shutdown restores the default slog handler, then shuts the providers down in parallel, each within its own budget,
+	// then stops the transport. Each registered cleanup will be invoked once; failures are reported as a [*ShutdownError].
+	// Concurrent calls wait for the first to complete.
 	shutdown = func(ctx context.Context) error {
-		var e error
-		for _, fn := range shutdowns {
-			e = errors.Join(e, fn(ctx))
-		}
+		mutex.Lock()
+		defer mutex.Unlock()
 
+		pending := shutdowns
 		shutdowns = nil
-		return e
+
+		return stages(ctx, pending...)
+	}
+
+	// Apply the transport and credentials configurations to all exporters' options; its shutdown handler runs after the providers'.
+	stop := transport(ctx, o)
+
+	// Set up trace provider.
+	o.pipeline.tracer = traces(ctx, o)
+
+	// Set up meter provider.
+	meter := metrics(ctx, o)
+	o.pipeline.meter = meter
+
+	// Report the number of redactions performed, if configured.
+	if o.Redactor != nil {
+		if e := o.Redactor.instrument(meter); e != nil {
+			e = fmt.Errorf("unable to register redactions counter: %w", e)
+			slog.ErrorContext(ctx, "Fatal Open-Telemetry Error", slog.String("error", e.Error()))
+			panic(e)
+		}
 	}
 
-	// Set up trace provider and add shutdown handler.
-	shutdowns = append(shutdowns, traces(ctx, o).Shutdown)
+	// Set the global logger provider.
+	logger := logexporter(ctx, o)
+	o.pipeline.logger = logger
 
-	// Set up meter provider and add shutdown handler.
-	shutdowns = append(shutdowns, metrics(ctx, o).Shutdown)
+	shutdowns = [][]component{
+		// Install the bridged default slog handler, if configured; the original is restored before any provider shuts down.
+		{{name: "bridge", shutdown: bridge(o, logger)}},
 
-	// Set the global logger provider and add shutdown handler.
-	shutdowns = append(shutdowns, logexporter(ctx, o).Shutdown)
+		// Add the providers' shutdown handlers.
+		{
+			{name: "traces", budget: o.Tracer.Budget, shutdown: o.pipeline.tracer.Shutdown},
+			{name: "metrics", budget: o.Metrics.Budget, shutdown: meter.Shutdown},
+			{name: "logs", budget: o.Logs.Budget, shutdown: logger.Shutdown},
+		},
+
+		// Stop watching the transport's certificate files.
+		{{name: "transport", shutdown: stop}},
+	}
 
 	// Set up the global propagator.
-	propagator(o)
+	propagator(ctx, o)
+
+	// Capture the parent process's propagated context, if configured.
+	inherit(o)
+
+	// Expose the pipeline to the runtime flush, diagnostics and exit handlers.
+	o.pipeline.shutdown = shutdown
+
+	active.Store(o.pipeline)
+
+	// Track open spans of the new pipeline if a diagnostics handler is already running.
+	if diagnosing.Load() > 0 {
+		o.pipeline.track()
+	}
 
 	return
 }
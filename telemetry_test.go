@@ -11,7 +11,6 @@ import (
 	"os"
 	"syscall"
 	"testing"
-	"time"
 
 	"go.opentelemetry.io/contrib/bridges/otelslog"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -69,7 +68,9 @@ func Test(t *testing.T) {
 			<-ctx.Done()
 		})
 
-		time.Sleep(10 * time.Second)
+		if e := telemetry.Flush(ctx); e != nil {
+			t.Fatalf("Unexpected Error While Flushing: %v", e)
+		}
 
 		if metrics.Len() == 0 {
 			t.Error("No Metrics Received")
@@ -165,9 +166,13 @@ func Test(t *testing.T) {
 			t.Fatalf("Unexpected Error While Sending Request: %v", e)
 		}
 
-		defer response.Body.Close()
+		response.Body.Close()
+
+		server.Close() // blocks until the handler, and therefore its spans, completes
 
-		time.Sleep(10 * time.Second)
+		if e := telemetry.Flush(ctx); e != nil {
+			t.Fatalf("Unexpected Error While Flushing: %v", e)
+		}
 
 		t.Run("Traces", func(t *testing.T) {
 			if traces.Len() == 0 {
@@ -0,0 +1,398 @@
+package telemetry
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/zipkin"
+)
+
+// Transport represents a signal-agnostic transport configuration applied to every exporter built by [Setup].
+//
+// Certificate files are watched for changes and reloaded without restarting the process, allowing rotation by tools such as
+// cert-manager or a Vault agent.
+type Transport struct {
+	// Endpoint, if set, is the collector's "host:port", shared by all signals and overriding each signal's endpoint options.
+	// The signals' default url paths ("/v1/traces", "/v1/metrics" and "/v1/logs") are used.
+	//
+	// Defaults to empty, in which case each signal's endpoint options apply, and their exporters use TLS unless
+	// [Transport.Insecure] is set, or a signal's own options include WithInsecure.
+	Endpoint string
+
+	// CA is an optional path to a PEM-encoded certificate authority bundle used to verify the collector. Defaults to the system's certificate pool.
+	CA string
+
+	// Certificate is an optional path to a PEM-encoded client certificate. If set alongside [Transport.Key], enables mTLS.
+	Certificate string
+
+	// Key is an optional path to the PEM-encoded private key of [Transport.Certificate].
+	Key string
+
+	// ServerName overrides the hostname used to verify the collector's certificate. Defaults to the endpoint's hostname;
+	// required when [Transport.CA] is set for signals' endpoints given as IP addresses, unless [Transport.Endpoint] is set.
+	ServerName string
+
+	// Compression enables gzip compression of export requests. Default is false.
+	Compression bool
+
+	// Insecure disables TLS; only [Transport.Endpoint] and [Transport.Compression] then apply. Default is false.
+	Insecure bool
+
+	// Reload is the interval between checks for changed certificate files. Defaults to 30 seconds. A negative value disables reloading.
+	Reload time.Duration
+}
+
+// certificates holds the reloadable certificate material of a [Transport].
+type certificates struct {
+	settings *Transport
+
+	mutex       sync.RWMutex
+	pool        *x509.CertPool
+	certificate *tls.Certificate
+	modified    map[string]time.Time
+
+	done chan struct{}
+	once sync.Once
+}
+
+// files returns the certificate file paths configured for the transport.
+func (c *certificates) files() []string {
+	var files []string
+	for _, path := range []string{c.settings.CA, c.settings.Certificate, c.settings.Key} {
+		if path != "" {
+			files = append(files, path)
+		}
+	}
+
+	return files
+}
+
+// load (re)reads all certificate material. On error, previously loaded material remains in use.
+func (c *certificates) load() error {
+	modified := make(map[string]time.Time)
+	for _, path := range c.files() {
+		info, e := os.Stat(path)
+		if e != nil {
+			return fmt.Errorf("unable to stat certificate file: %w", e)
+		}
+
+		modified[path] = info.ModTime()
+	}
+
+	var pool *x509.CertPool
+	if c.settings.CA != "" {
+		content, e := os.ReadFile(c.settings.CA)
+		if e != nil {
+			return fmt.Errorf("unable to read certificate authority bundle: %w", e)
+		}
+
+		pool = x509.NewCertPool()
+		if !(pool.AppendCertsFromPEM(content)) {
+			return fmt.Errorf("no valid certificates found in certificate authority bundle %q", c.settings.CA)
+		}
+	}
+
+	var certificate *tls.Certificate
+	if c.settings.Certificate != "" || c.settings.Key != "" {
+		pair, e := tls.LoadX509KeyPair(c.settings.Certificate, c.settings.Key)
+		if e != nil {
+			return fmt.Errorf("unable to load client certificate: %w", e)
+		}
+
+		certificate = &pair
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.pool = pool
+	c.certificate = certificate
+	c.modified = modified
+
+	return nil
+}
+
+// changed reports whether any certificate file's modification time differs from when it was last loaded.
+func (c *certificates) changed() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	for _, path := range c.files() {
+		info, e := os.Stat(path)
+		if e != nil {
+			continue // Files are commonly replaced non-atomically; retry on the next tick.
+		}
+
+		if !(info.ModTime().Equal(c.modified[path])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// watch periodically reloads certificate material until stop is called.
+func (c *certificates) watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			if !(c.changed()) {
+				continue
+			}
+
+			if e := c.load(); e != nil {
+				slog.WarnContext(ctx, "Unable to Reload Telemetry Transport Certificates", slog.String("error", e.Error()))
+				continue
+			}
+
+			slog.InfoContext(ctx, "Reloaded Telemetry Transport Certificates")
+		}
+	}
+}
+
+// stop terminates the watcher; safe for repeated calls.
+func (c *certificates) stop(context.Context) error {
+	c.once.Do(func() {
+		close(c.done)
+	})
+
+	return nil
+}
+
+// client returns the currently loaded client certificate, if any.
+func (c *certificates) client(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if c.certificate == nil {
+		return new(tls.Certificate), nil
+	}
+
+	return c.certificate, nil
+}
+
+// verifier returns a function validating the server's certificate chain against the currently loaded certificate authority
+// bundle. The chain must be valid for name, unless [Transport.ServerName] is set; if both are empty, the connection's
+// server name is used.
+func (c *certificates) verifier(name string) func(state tls.ConnectionState) error {
+	if c.settings.ServerName != "" {
+		name = c.settings.ServerName
+	}
+
+	return func(state tls.ConnectionState) error {
+		c.mutex.RLock()
+		pool := c.pool
+		c.mutex.RUnlock()
+
+		if len(state.PeerCertificates) == 0 {
+			return errors.New("collector presented no certificates")
+		}
+
+		host := name
+		if host == "" {
+			host = state.ServerName
+		}
+
+		// The connection's server name is empty for IP addresses, which aren't sent via SNI.
+		if host == "" {
+			return errors.New("unable to verify the collector's certificate without a server name; set the transport's server name")
+		}
+
+		intermediates := x509.NewCertPool()
+		for _, certificate := range state.PeerCertificates[1:] {
+			intermediates.AddCert(certificate)
+		}
+
+		_, e := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+			Roots:         pool,
+			DNSName:       host,
+			Intermediates: intermediates,
+		})
+
+		return e
+	}
+}
+
+// config returns a [tls.Config] for connections to the host of address, resolving certificates from c on every handshake.
+// An empty address verifies each connection against its own server name.
+func (c *certificates) config(address string) *tls.Config {
+	configuration := &tls.Config{
+		MinVersion:           tls.VersionTLS12,
+		ServerName:           c.settings.ServerName,
+		GetClientCertificate: c.client,
+	}
+
+	if c.settings.CA != "" {
+		name, _, e := net.SplitHostPort(address)
+		if e != nil {
+			name = address
+		}
+
+		// Standard verification only supports a static pool; verification is instead performed by VerifyConnection against
+		// the reloadable one.
+		configuration.InsecureSkipVerify = true
+		configuration.VerifyConnection = c.verifier(name)
+	}
+
+	return configuration
+}
+
+// transport applies the [Transport] and [Settings.Credentials] configurations to every exporter's options. The returned
+// function stops the certificate watcher, if any.
+func transport(ctx context.Context, settings *Settings) (shutdown func(context.Context) error) {
+	shutdown = func(context.Context) error { return nil }
+
+	var configuration *tls.Config
+	if settings.Transport != nil {
+		configuration, shutdown = secure(ctx, settings)
+	}
+
+	if settings.Credentials != nil {
+		// A custom client takes precedence over the WithTLSClientConfig, WithProxy and WithTimeout options; the client carries
+		// the transport's TLS configuration, and the timeouts the exporters would otherwise read from the environment.
+		client := func(signal string) *http.Client {
+			t := http.DefaultTransport.(*http.Transport).Clone()
+			t.TLSClientConfig = configuration
+
+			return &http.Client{Transport: &authorization{base: t, credentials: settings.Credentials}, Timeout: timeout(signal)}
+		}
+
+		settings.Tracer.Options = append(settings.Tracer.Options, otlptracehttp.WithHTTPClient(client("TRACES")))
+		settings.Metrics.Options = append(settings.Metrics.Options, otlpmetrichttp.WithHTTPClient(client("METRICS")))
+		settings.Logs.Options = append(settings.Logs.Options, otlploghttp.WithHTTPClient(client("LOGS")))
+	} else if configuration != nil {
+		settings.Tracer.Options = append(settings.Tracer.Options, otlptracehttp.WithTLSClientConfig(configuration))
+		settings.Metrics.Options = append(settings.Metrics.Options, otlpmetrichttp.WithTLSClientConfig(configuration))
+		settings.Logs.Options = append(settings.Logs.Options, otlploghttp.WithTLSClientConfig(configuration))
+	}
+
+	return
+}
+
+// timeout returns the export timeout of the signal ("TRACES", "METRICS" or "LOGS"), read in milliseconds from the
+// OTEL_EXPORTER_OTLP_{SIGNAL}_TIMEOUT or OTEL_EXPORTER_OTLP_TIMEOUT environment variables, as by the exporters. Defaults to
+// 10 seconds.
+func timeout(signal string) time.Duration {
+	for _, name := range []string{"OTEL_EXPORTER_OTLP_" + signal + "_TIMEOUT", "OTEL_EXPORTER_OTLP_TIMEOUT"} {
+		if value, e := strconv.Atoi(os.Getenv(name)); e == nil && value > 0 {
+			return time.Duration(value) * time.Millisecond
+		}
+	}
+
+	return 10 * time.Second
+}
+
+// secure applies the endpoint and compression of [Settings.Transport], and loads its certificates. The returned
+// configuration is nil if TLS is disabled.
+func secure(ctx context.Context, settings *Settings) (configuration *tls.Config, shutdown func(context.Context) error) {
+	shutdown = func(context.Context) error { return nil }
+
+	scheme := "https"
+	if settings.Transport.Insecure {
+		scheme = "http"
+	}
+
+	host := settings.Transport.Endpoint
+
+	switch {
+	case host != "":
+		// [otlptracehttp.WithEndpointURL] (and its metric and log equivalents) is the only option that overrides a preceding
+		// WithInsecure, as found in the defaults; the scheme determines whether TLS is used.
+		settings.Tracer.Options = append(settings.Tracer.Options, otlptracehttp.WithEndpointURL(fmt.Sprintf("%s://%s/v1/traces", scheme, host)))
+		settings.Metrics.Options = append(settings.Metrics.Options, otlpmetrichttp.WithEndpointURL(fmt.Sprintf("%s://%s/v1/metrics", scheme, host)))
+		settings.Logs.Options = append(settings.Logs.Options, otlploghttp.WithEndpointURL(fmt.Sprintf("%s://%s/v1/logs", scheme, host)))
+	case settings.Transport.Insecure:
+		settings.Tracer.Options = append(settings.Tracer.Options, otlptracehttp.WithInsecure())
+		settings.Metrics.Options = append(settings.Metrics.Options, otlpmetrichttp.WithInsecure())
+		settings.Logs.Options = append(settings.Logs.Options, otlploghttp.WithInsecure())
+	default:
+		// Keep each signal's endpoint; without the defaults' WithInsecure, the exporters use TLS.
+		settings.Tracer.Options = secured(settings.Tracer.Options)
+		settings.Metrics.Options = secured(settings.Metrics.Options)
+		settings.Logs.Options = secured(settings.Logs.Options)
+	}
+
+	if settings.Transport.Compression {
+		settings.Tracer.Options = append(settings.Tracer.Options, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+		settings.Metrics.Options = append(settings.Metrics.Options, otlpmetrichttp.WithCompression(otlpmetrichttp.GzipCompression))
+		settings.Logs.Options = append(settings.Logs.Options, otlploghttp.WithCompression(otlploghttp.GzipCompression))
+	}
+
+	if settings.Transport.Insecure {
+		return
+	}
+
+	c := &certificates{settings: settings.Transport, done: make(chan struct{})}
+	if e := c.load(); e != nil {
+		e = fmt.Errorf("unable to load telemetry transport certificates: %w", e)
+		slog.ErrorContext(ctx, "Fatal Open-Telemetry Error", slog.String("error", e.Error()))
+		panic(e)
+	}
+
+	configuration = c.config(host)
+
+	if u, e := url.Parse(settings.Zipkin.URL); e == nil && u.Scheme == "https" {
+		t := http.DefaultTransport.(*http.Transport).Clone()
+		t.TLSClientConfig = c.config(u.Host)
+
+		settings.Zipkin.Options = append(settings.Zipkin.Options, zipkin.WithClient(&http.Client{Transport: t}))
+	}
+
+	interval := settings.Transport.Reload
+	if interval == 0 {
+		interval = 30 * time.Second
+	}
+
+	if interval > 0 && len(c.files()) > 0 {
+		go c.watch(ctx, interval)
+	}
+
+	return configuration, c.stop
+}
+
+// insecure is implemented by the WithInsecure options set by [Options], which [Transport] removes to enable TLS without
+// overriding the signals' endpoints.
+type insecure interface {
+	insecure()
+}
+
+type insecureTraces struct{ otlptracehttp.Option }
+
+func (insecureTraces) insecure() {}
+
+type insecureMetrics struct{ otlpmetrichttp.Option }
+
+func (insecureMetrics) insecure() {}
+
+type insecureLogs struct{ otlploghttp.Option }
+
+func (insecureLogs) insecure() {}
+
+// secured returns a copy of options without the default WithInsecure options; see [insecure].
+func secured[T any](options []T) []T {
+	return slices.DeleteFunc(slices.Clone(options), func(option T) bool {
+		_, ok := any(option).(insecure)
+
+		return ok
+	})
+}
@@ -0,0 +1,269 @@
+package telemetry_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/poly-gun/go-telemetry"
+)
+
+// authority is a throw-away certificate authority issuing test certificates.
+type authority struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	serial      int64
+}
+
+func (a *authority) issue(t *testing.T, name string, usage x509.ExtKeyUsage) (certificate []byte, key []byte) {
+	t.Helper()
+
+	private, e := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if e != nil {
+		t.Fatalf("Unexpected Error While Generating Key: %v", e)
+	}
+
+	a.serial++
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(a.serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		DNSNames:     []string{"localhost"},
+	}
+
+	parent, signer := a.certificate, any(a.key)
+	if a.certificate == nil { // self-signed authority
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, signer = template, private
+	}
+
+	der, e := x509.CreateCertificate(rand.Reader, template, parent, &private.PublicKey, signer)
+	if e != nil {
+		t.Fatalf("Unexpected Error While Creating Certificate: %v", e)
+	}
+
+	marshalled, e := x509.MarshalECPrivateKey(private)
+	if e != nil {
+		t.Fatalf("Unexpected Error While Marshalling Key: %v", e)
+	}
+
+	if a.certificate == nil {
+		a.certificate, _ = x509.ParseCertificate(der)
+		a.key = private
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: marshalled})
+}
+
+func TestTransport(t *testing.T) {
+	t.Run("Telemetry-mTLS-Certificate-Reload", func(t *testing.T) {
+		ctx := context.Background()
+
+		directory := t.TempDir()
+
+		ca := new(authority)
+		root, _ := ca.issue(t, "test-authority", x509.ExtKeyUsageAny)
+		serverCertificate, serverKey := ca.issue(t, "test-collector", x509.ExtKeyUsageServerAuth)
+
+		write := func(name string, content []byte) string {
+			path := filepath.Join(directory, name)
+			if e := os.WriteFile(path, content, 0o600); e != nil {
+				t.Fatalf("Unexpected Error While Writing %s: %v", name, e)
+			}
+
+			return path
+		}
+
+		rotate := func(name string) (string, string) {
+			certificate, key := ca.issue(t, name, x509.ExtKeyUsageClientAuth)
+
+			c, k := write("client.crt", certificate), write("client.key", key)
+
+			// Guarantee a modification time change regardless of the filesystem's timestamp granularity.
+			future := time.Now().Add(time.Duration(ca.serial) * time.Second)
+			for _, path := range []string{c, k} {
+				if e := os.Chtimes(path, future, future); e != nil {
+					t.Fatalf("Unexpected Error While Updating Modification Time: %v", e)
+				}
+			}
+
+			return c, k
+		}
+
+		authorityPath := write("ca.crt", root)
+		certificatePath, keyPath := rotate("client-a")
+
+		pair, e := tls.X509KeyPair(serverCertificate, serverKey)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Loading Server Certificate: %v", e)
+		}
+
+		pool := x509.NewCertPool()
+		pool.AddCert(ca.certificate)
+
+		var mutex sync.Mutex
+		var clients, encodings []string
+
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if r.URL.Path == "/v1/traces" {
+				clients = append(clients, r.TLS.PeerCertificates[0].Subject.CommonName)
+				encodings = append(encodings, r.Header.Get("Content-Encoding"))
+			}
+
+			w.Header().Set("Connection", "close") // force a new handshake, i.e. client certificate, per request.
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		server.TLS = &tls.Config{
+			Certificates: []tls.Certificate{pair},
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			ClientCAs:    pool,
+		}
+
+		server.StartTLS()
+		defer server.Close()
+
+		shutdown := telemetry.Setup(ctx, func(options *telemetry.Settings) {
+			options.Zipkin.Enabled = false // disabled during testing
+
+			options.Transport = &telemetry.Transport{
+				Endpoint:    server.Listener.Addr().String(),
+				CA:          authorityPath,
+				Certificate: certificatePath,
+				Key:         keyPath,
+				Compression: true,
+				Reload:      25 * time.Millisecond,
+			}
+		})
+
+		defer shutdown(ctx)
+
+		provider := otel.GetTracerProvider().(*trace.TracerProvider)
+
+		export := func() {
+			_, span := otel.Tracer("transport-test").Start(ctx, "transport-test-span")
+			span.End()
+
+			if e := provider.ForceFlush(ctx); e != nil {
+				t.Fatalf("Unexpected Error While Flushing Traces: %v", e)
+			}
+		}
+
+		export()
+
+		rotate("client-b")
+
+		time.Sleep(250 * time.Millisecond) // several reload intervals.
+
+		export()
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if len(clients) != 2 {
+			t.Fatalf("Expected 2 Trace Export Requests, Received %d", len(clients))
+		}
+
+		if clients[0] != "client-a" || clients[1] != "client-b" {
+			t.Errorf("Client Certificate Not Reloaded: %v", clients)
+		}
+
+		for _, encoding := range encodings {
+			if encoding != "gzip" {
+				t.Errorf("Unexpected Content-Encoding: %q", encoding)
+			}
+		}
+	})
+
+	t.Run("Telemetry-Transport-Signal-Endpoint", func(t *testing.T) {
+		ctx := context.Background()
+
+		ca := new(authority)
+		root, _ := ca.issue(t, "test-authority", x509.ExtKeyUsageAny)
+		serverCertificate, serverKey := ca.issue(t, "test-collector", x509.ExtKeyUsageServerAuth)
+
+		authorityPath := filepath.Join(t.TempDir(), "ca.crt")
+		if e := os.WriteFile(authorityPath, root, 0o600); e != nil {
+			t.Fatalf("Unexpected Error While Writing Certificate Authority: %v", e)
+		}
+
+		pair, e := tls.X509KeyPair(serverCertificate, serverKey)
+		if e != nil {
+			t.Fatalf("Unexpected Error While Loading Server Certificate: %v", e)
+		}
+
+		var mutex sync.Mutex
+		var paths []string
+
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			paths = append(paths, r.URL.Path)
+
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		server.TLS = &tls.Config{Certificates: []tls.Certificate{pair}}
+
+		server.StartTLS()
+		defer server.Close()
+
+		_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+
+		shutdown := telemetry.Setup(ctx, func(options *telemetry.Settings) {
+			options.Zipkin.Enabled = false // disabled during testing
+
+			// The signal's own endpoint is kept, and reached via TLS despite the defaults' WithInsecure.
+			options.Tracer.Options = append(options.Tracer.Options, otlptracehttp.WithEndpoint("localhost:"+port), otlptracehttp.WithURLPath("/custom/traces"))
+
+			options.Transport = &telemetry.Transport{
+				CA: authorityPath,
+			}
+		})
+
+		defer shutdown(ctx)
+
+		_, span := otel.Tracer("transport-test").Start(ctx, "transport-test-span")
+		span.End()
+
+		if e := otel.GetTracerProvider().(*trace.TracerProvider).ForceFlush(ctx); e != nil {
+			t.Fatalf("Unexpected Error While Flushing Traces: %v", e)
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if len(paths) != 1 || paths[0] != "/custom/traces" {
+			t.Errorf("Unexpected Trace Export Requests: %v", paths)
+		}
+	})
+}